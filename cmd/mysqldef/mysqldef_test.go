@@ -15,7 +15,6 @@ import (
 	"github.com/sqldef/sqldef/v2/cmd/testutils"
 	"github.com/sqldef/sqldef/v2/database"
 	"github.com/sqldef/sqldef/v2/database/mysql"
-	"github.com/sqldef/sqldef/v2/parser"
 	"github.com/sqldef/sqldef/v2/schema"
 )
 
@@ -37,7 +36,7 @@ func TestApply(t *testing.T) {
 	}
 
 	version := strings.TrimSpace(testutils.MustExecute("mysql", "-uroot", "-h", "127.0.0.1", "-sN", "-e", "select version();"))
-	sqlParser := database.NewParser(parser.ParserModeMysql)
+	sqlParser := mysql.NewParser()
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			// Initialize the database with test.Current
@@ -75,8 +74,7 @@ func TestMysqldefCreateTableChangePrimaryKey(t *testing.T) {
 	)
 
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `friends` DROP PRIMARY KEY;\n"+
-		"ALTER TABLE `friends` ADD PRIMARY KEY (`user_id`, `friend_id`);\n",
+		"ALTER TABLE `friends` DROP PRIMARY KEY, ADD PRIMARY KEY (`user_id`, `friend_id`);\n",
 	)
 	assertApplyOutput(t, createTable, nothingModified)
 }
@@ -106,8 +104,7 @@ func TestMysqldefCreateTableChangePrimaryKeyWithComment(t *testing.T) {
 	)
 
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `friends` DROP PRIMARY KEY;\n"+
-		"ALTER TABLE `friends` ADD PRIMARY KEY (`user_id`, `friend_id`) COMMENT 'new primary key';\n",
+		"ALTER TABLE `friends` DROP PRIMARY KEY, ADD PRIMARY KEY (`user_id`, `friend_id`) COMMENT 'new primary key';\n",
 	)
 	assertApplyOutput(t, createTable, nothingModified)
 }
@@ -134,8 +131,7 @@ func TestMysqldefCreateTableAddAutoIncrementPrimaryKey(t *testing.T) {
 	)
 
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `users` ADD COLUMN `id` bigint NOT NULL FIRST;\n"+
-		"ALTER TABLE `users` ADD PRIMARY KEY (`id`);\n"+
+		"ALTER TABLE `users` ADD COLUMN `id` bigint NOT NULL FIRST, ADD PRIMARY KEY (`id`);\n"+
 		"ALTER TABLE `users` CHANGE COLUMN `id` `id` bigint NOT NULL AUTO_INCREMENT;\n",
 	)
 	assertApplyOutput(t, createTable, nothingModified)
@@ -295,8 +291,7 @@ func TestMysqldefChangeColumn(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
-		ALTER TABLE `+"`users`"+` CHANGE COLUMN `+"`id` `id`"+` bigint UNSIGNED NOT NULL;
-		ALTER TABLE `+"`users`"+` CHANGE COLUMN `+"`name` `name`"+` char(40);
+		ALTER TABLE `+"`users`"+` CHANGE COLUMN `+"`id` `id`"+` bigint UNSIGNED NOT NULL, CHANGE COLUMN `+"`name` `name`"+` char(40);
 		`,
 	))
 	assertApplyOutput(t, createTable, nothingModified)
@@ -425,8 +420,7 @@ func TestMysqldefChangeGenerateColumnGemerayedAlwaysAs(t *testing.T) {
 	)
 	assertApplyOptionsOutput(t, createTable, applyPrefix+stripHeredoc(`
 		ALTER TABLE `+"`test_table`"+` DROP COLUMN `+"`test_expr`"+`;
-		ALTER TABLE `+"`test_table`"+` ADD COLUMN `+"`test_expr`"+` varchar(45) GENERATED ALWAYS AS (test_value / test_value) STORED AFTER `+"`test_value`"+`;
-		ALTER TABLE `+"`test_table`"+` DROP COLUMN `+"`name`"+`;
+		ALTER TABLE `+"`test_table`"+` ADD COLUMN `+"`test_expr`"+` varchar(45) GENERATED ALWAYS AS (test_value / test_value) STORED AFTER `+"`test_value`"+`, DROP COLUMN `+"`name`"+`;
 		ALTER TABLE `+"`test_table`"+` ADD COLUMN `+"`name`"+` varchar(20) GENERATED ALWAYS AS (json_extract(data, '$.name2')) STORED AFTER `+"`data`"+`;
 		`,
 	), "--enable-drop")
@@ -458,8 +452,7 @@ func TestMysqldefChangeGenerateColumnGemerayedAlwaysAs(t *testing.T) {
 	)
 	assertApplyOptionsOutput(t, createTable, applyPrefix+stripHeredoc(`
 		ALTER TABLE `+"`test_table`"+` DROP COLUMN `+"`test_expr`"+`;
-		ALTER TABLE `+"`test_table`"+` ADD COLUMN `+"`test_expr`"+` varchar(45) GENERATED ALWAYS AS (test_value / test_value) STORED NOT NULL AFTER `+"`test_value`"+`;
-		ALTER TABLE `+"`test_table`"+` DROP COLUMN `+"`name`"+`;
+		ALTER TABLE `+"`test_table`"+` ADD COLUMN `+"`test_expr`"+` varchar(45) GENERATED ALWAYS AS (test_value / test_value) STORED NOT NULL AFTER `+"`test_value`"+`, DROP COLUMN `+"`name`"+`;
 		ALTER TABLE `+"`test_table`"+` ADD COLUMN `+"`name`"+` varchar(20) GENERATED ALWAYS AS (json_extract(data, '$.name2')) STORED NOT NULL AFTER `+"`data`"+`;
 		`,
 	), "--enable-drop")
@@ -617,7 +610,7 @@ func TestMysqldefAddIndex(t *testing.T) {
 	assertApplyOutput(t, createTable+alterTable, nothingModified)
 
 	alterTable = "ALTER TABLE `users` ADD INDEX `index_name`(`name`, `created_at`);\n"
-	assertApplyOptionsOutput(t, createTable+alterTable, applyPrefix+"ALTER TABLE `users` DROP INDEX `index_name`;\n"+alterTable, "--enable-drop")
+	assertApplyOptionsOutput(t, createTable+alterTable, applyPrefix+"ALTER TABLE `users` DROP INDEX `index_name`, ADD INDEX `index_name`(`name`, `created_at`);\n", "--enable-drop")
 	assertApplyOutput(t, createTable+alterTable, nothingModified)
 
 	assertApplyOutput(t, createTable, applyPrefix+"-- Skipped: ALTER TABLE `users` DROP INDEX `index_name`;\n")
@@ -856,8 +849,7 @@ func TestMysqldefCreateIndex(t *testing.T) {
 
 	assertApplyOutput(t, createTable, applyPrefix+"-- Skipped: ALTER TABLE `users` DROP INDEX `index_created_at`;\n"+"-- Skipped: ALTER TABLE `users` DROP INDEX `index_name`;\n")
 	assertApplyOptionsOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `users` DROP INDEX `index_created_at`;\n"+
-		"ALTER TABLE `users` DROP INDEX `index_name`;\n",
+		"ALTER TABLE `users` DROP INDEX `index_created_at`, DROP INDEX `index_name`;\n",
 		"--enable-drop",
 	)
 	assertApplyOutput(t, createTable, nothingModified)
@@ -888,8 +880,7 @@ func TestMysqldefCreateTableKey(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `users` ADD KEY `index_name` (`name`);\n"+
-		"ALTER TABLE `users` ADD UNIQUE KEY `index_created_at` (`created_at`);\n",
+		"ALTER TABLE `users` ADD KEY `index_name` (`name`), ADD UNIQUE KEY `index_created_at` (`created_at`);\n",
 	)
 	assertApplyOutput(t, createTable, nothingModified)
 }
@@ -925,8 +916,7 @@ func TestMysqldefCreateTableWithUniqueColumn(t *testing.T) {
 		`,
 	)
 	assertApplyOptionsOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `users` DROP INDEX `name`;\n"+
-		"ALTER TABLE `users` DROP COLUMN `name`;\n",
+		"ALTER TABLE `users` DROP INDEX `name`, DROP COLUMN `name`;\n",
 		"--enable-drop",
 	)
 	assertApplyOutput(t, createTable, nothingModified)
@@ -1477,8 +1467,7 @@ func TestMysqldefDefaultValue(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `tools` CHANGE COLUMN `created_at` `created_at` datetime NOT NULL DEFAULT current_timestamp;\n"+
-		"ALTER TABLE `tools` CHANGE COLUMN `updated_at` `updated_at` datetime NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp;\n")
+		"ALTER TABLE `tools` CHANGE COLUMN `created_at` `created_at` datetime NOT NULL DEFAULT current_timestamp, CHANGE COLUMN `updated_at` `updated_at` datetime NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp;\n")
 	assertApplyOutput(t, createTable, nothingModified)
 
 	createTable = stripHeredoc(`
@@ -1492,8 +1481,7 @@ func TestMysqldefDefaultValue(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `tools` CHANGE COLUMN `created_at` `created_at` datetime NOT NULL;\n"+
-		"ALTER TABLE `tools` CHANGE COLUMN `updated_at` `updated_at` datetime NOT NULL;\n")
+		"ALTER TABLE `tools` CHANGE COLUMN `created_at` `created_at` datetime NOT NULL, CHANGE COLUMN `updated_at` `updated_at` datetime NOT NULL;\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
@@ -1574,10 +1562,56 @@ func TestMysqldefChangeIndexCombination(t *testing.T) {
 		"  KEY `index_users2`(account_id)\n" +
 		");\n"
 	assertApplyOptionsOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `users` DROP INDEX `index_users1`;\n"+
-		"ALTER TABLE `users` ADD KEY `index_users1` (`account_id`, `name`);\n"+
-		"ALTER TABLE `users` DROP INDEX `index_users2`;\n"+
-		"ALTER TABLE `users` ADD KEY `index_users2` (`account_id`);\n", "--enable-drop")
+		"ALTER TABLE `users` DROP INDEX `index_users1`, ADD KEY `index_users1` (`account_id`, `name`), DROP INDEX `index_users2`, ADD KEY `index_users2` (`account_id`);\n", "--enable-drop")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefMergeAlterTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40) NOT NULL,
+		  old int DEFAULT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	// AUTO_INCREMENT is removed before changing the primary key and added after it in another statement
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT,
+		  name varchar(40) NOT NULL,
+		  old int DEFAULT NULL,
+		  age int DEFAULT NULL,
+		  PRIMARY KEY (id, name)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` ADD COLUMN `age` int DEFAULT null AFTER `old`, CHANGE COLUMN `id` `id` bigint NOT NULL, DROP PRIMARY KEY, ADD PRIMARY KEY (`id`, `name`);\n"+
+		"ALTER TABLE `users` CHANGE COLUMN `id` `id` bigint NOT NULL AUTO_INCREMENT;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Skipped changes don't split the statement
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT,
+		  name varchar(40) NOT NULL,
+		  age int DEFAULT NULL,
+		  PRIMARY KEY (id, name),
+		  KEY index_age (age)
+		) COMMENT = 'users';
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` ADD KEY `index_age` (`age`), COMMENT = 'users';\n"+
+		"-- Skipped: ALTER TABLE `users` DROP COLUMN `old`;\n",
+	)
+	assertApplyOptionsOutput(t, createTable, applyPrefix+"ALTER TABLE `users` DROP COLUMN `old`;\n", "--enable-drop")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
@@ -1641,6 +1675,24 @@ func TestMysqldefExport(t *testing.T) {
 	assertEquals(t, out, ddls)
 }
 
+func TestMysqldefExportRoutines(t *testing.T) {
+	resetTestDatabase()
+
+	testutils.MustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
+		CREATE DEFINER = CURRENT_USER PROCEDURE noop() SELECT 1;
+		CREATE FUNCTION add_one(n int) RETURNS int DETERMINISTIC RETURN n + 1;
+		`,
+	))
+	out := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--export")
+	assertEquals(t, out, "CREATE FUNCTION `add_one`(n int) RETURNS int\n"+
+		"    DETERMINISTIC\n"+
+		"RETURN n + 1;\n"+
+		"\n"+
+		"CREATE PROCEDURE `noop`()\n"+
+		"SELECT 1;\n",
+	)
+}
+
 func TestMysqldefExportConcurrently(t *testing.T) {
 	resetTestDatabase()
 
@@ -1842,6 +1894,152 @@ func TestMysqldefConfigIncludesLock(t *testing.T) {
 
 }
 
+func TestMysqldefConfigIncludesManageAutoIncrement(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) AUTO_INCREMENT=10;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) AUTO_INCREMENT=100;
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	writeFile("schema.sql", createTable)
+	writeFile("config.yml", "manage_auto_increment: true")
+
+	apply := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+"ALTER TABLE `users` AUTO_INCREMENT=100;\n")
+	apply = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestMysqldefConfigIncludesAllowRepartition(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		) PARTITION BY HASH (id) PARTITIONS 4;
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	out, err := testutils.Execute("./mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected repartitioning without allow_repartition to fail but succeeded with: %s", out)
+	}
+	if !strings.Contains(out, "requires allow_repartition in the config") {
+		t.Errorf("expected an allow_repartition error but got: %s", out)
+	}
+
+	writeFile("config.yml", "allow_repartition: true")
+	apply := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+"ALTER TABLE `users` PARTITION BY HASH (id) PARTITIONS 4;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefConfigIncludesOnlineSchemaChange(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) DEFAULT NULL
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL PRIMARY KEY,
+		  user_id bigint NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) DEFAULT NULL,
+		  age int DEFAULT NULL,
+		  KEY index_name (name)
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL PRIMARY KEY,
+		  user_id bigint NOT NULL,
+		  title varchar(40) DEFAULT NULL
+		);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+
+	// A stub of gh-ost that records its invocation and runs the ALTER TABLE by itself
+	writeFile("osc.sh", stripHeredoc(`
+		echo "$SQLDEF_DATABASE $SQLDEF_TABLE $SQLDEF_ALTER" >> osc.log
+		mysql -uroot "$SQLDEF_DATABASE" -e "ALTER TABLE $SQLDEF_TABLE $SQLDEF_ALTER"
+		`,
+	))
+	os.Remove("osc.log")
+	writeFile("config.yml", "algorithm: inplace\nonline_schema_change: sh osc.sh\nonline_schema_change_tables: users\n")
+
+	apply := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER TABLE `+"`users`"+` ADD COLUMN `+"`age`"+` int DEFAULT null AFTER `+"`name`"+`, ADD KEY `+"`index_name` (`name`)"+`;
+		ALTER TABLE `+"`posts`"+` ADD COLUMN `+"`title`"+` varchar(40) DEFAULT null AFTER `+"`user_id`"+`, ALGORITHM=INPLACE;
+		`,
+	))
+	invocations, err := os.ReadFile("osc.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, string(invocations), "mysqldef_test users ADD COLUMN `age` int DEFAULT null AFTER `name`, ADD KEY `index_name` (`name`)\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefConfigIncludesMergeAlterTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) DEFAULT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) NOT NULL,
+		  KEY index_name (name)
+		);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+	writeFile("config.yml", "merge_alter_table: false\n")
+
+	apply := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+
+		"ALTER TABLE `users` CHANGE COLUMN `name` `name` varchar(40) NOT NULL;\n"+
+		"ALTER TABLE `users` ADD KEY `index_name` (`name`);\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefHelp(t *testing.T) {
 	_, err := testutils.Execute("./mysqldef", "--help")
 	if err != nil {
@@ -1865,6 +2063,8 @@ func TestMain(m *testing.M) {
 	os.Remove("mysqldef")
 	os.Remove("schema.sql")
 	os.Remove("config.yml")
+	os.Remove("osc.sh")
+	os.Remove("osc.log")
 	os.Exit(status)
 }
 
@@ -524,11 +524,18 @@ func TestPsqlddefCreatePolicy(t *testing.T) {
 		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (true);
 		`,
 	)
-	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+stripHeredoc(`
-		DROP POLICY "p_users" ON "public"."users";
-		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (true);
+	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+
+		`ALTER POLICY "p_users" ON "public"."users" USING (true);`+"\n",
+	)
+	assertApplyOutput(t, createUsers+createPolicy, nothingModified)
+
+	createPolicy = stripHeredoc(`
+		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO PUBLIC USING (id > 0);
 		`,
-	))
+	)
+	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+
+		`ALTER POLICY "p_users" ON "public"."users" TO PUBLIC USING (id > 0);`+"\n",
+	)
 	assertApplyOutput(t, createUsers+createPolicy, nothingModified)
 
 	assertApplyOutput(t, createUsers, applyPrefix+`DROP POLICY "p_users" ON "public"."users";`+"\n")
@@ -599,6 +606,29 @@ func TestPsqldefCreateMaterializedView(t *testing.T) {
 	}
 }
 
+func TestPsqldefChangeMaterializedView(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE points (id bigint, point_type smallint);\n"
+	assertApply(t, createTable+"CREATE MATERIALIZED VIEW points_view AS SELECT id, point_type FROM points WHERE point_type = 1;\n")
+
+	// Recreating a materialized view loses its data, which requires enable_drop
+	createMaterializedView := "CREATE MATERIALIZED VIEW points_view AS SELECT id, point_type FROM points WHERE point_type = 2;\n"
+	assertApplyOptionsOutput(t, createTable+createMaterializedView, "-- dry run --\n"+stripHeredoc(`
+		-- Skipped: DROP MATERIALIZED VIEW "public"."points_view";
+		CREATE MATERIALIZED VIEW "public"."points_view" AS select id, point_type from points where point_type = 2;
+		`,
+	), "--dry-run")
+
+	writeFile("config.yml", "enable_drop: |\n  view\n")
+	assertApplyOptionsOutput(t, createTable+createMaterializedView, applyPrefix+stripHeredoc(`
+		DROP MATERIALIZED VIEW "public"."points_view";
+		CREATE MATERIALIZED VIEW "public"."points_view" AS select id, point_type from points where point_type = 2;
+		`,
+	), "--config", "config.yml")
+	assertApplyOutput(t, createTable+createMaterializedView, nothingModified)
+}
+
 func TestPsqldefDropPrimaryKey(t *testing.T) {
 	resetTestDatabase()
 
@@ -1211,6 +1241,26 @@ func TestPsqldefFunctionAsDefault(t *testing.T) {
 	}
 }
 
+func TestPsqldefReplaceConstraintTrigger(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		CREATE FUNCTION check_user() RETURNS trigger LANGUAGE plpgsql AS $$ BEGIN RETURN NULL; END $$;
+		`,
+	)
+	createTrigger := "CREATE CONSTRAINT TRIGGER users_check AFTER INSERT ON users FOR EACH ROW EXECUTE FUNCTION check_user();\n"
+	assertApply(t, createTable+createTrigger)
+
+	// Recreating a constraint trigger doesn't require --enable-drop
+	createTrigger = "CREATE CONSTRAINT TRIGGER users_check AFTER INSERT ON users DEFERRABLE INITIALLY DEFERRED FOR EACH ROW EXECUTE FUNCTION check_user();\n"
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+`DROP TRIGGER "users_check" ON "public"."users";`+"\n"+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+}
+
 //
 // ----------------------- following tests are for CLI -----------------------
 //
@@ -1320,6 +1370,21 @@ func TestPsqldefExportCompositePrimaryKey(t *testing.T) {
 	))
 }
 
+func TestPsqldefExportFunction(t *testing.T) {
+	resetTestDatabase()
+	mustExecuteSQL(`
+        CREATE TABLE users (id bigint PRIMARY KEY, name text);
+        CREATE FUNCTION user_count() RETURNS bigint LANGUAGE sql AS 'SELECT count(*) FROM users';
+    `)
+
+	// The export is applied to an empty database, where the body of the function is validated after the table exists
+	export := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "--export")
+	resetTestDatabase()
+	writeFile("schema.sql", export)
+	assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "--file", "schema.sql")
+	assertApplyOutput(t, export, nothingModified)
+}
+
 func TestPsqldefExportConcurrency(t *testing.T) {
 	resetTestDatabase()
 
@@ -1578,6 +1643,194 @@ func TestPsqldefConfigIncludesSkipViews(t *testing.T) {
 	assertEquals(t, apply, nothingModified)
 }
 
+func TestPsqldefConfigRecreateEnums(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL(`
+        CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');
+        CREATE TABLE users (id bigint PRIMARY KEY, mood mood NOT NULL DEFAULT 'ok');
+        INSERT INTO users VALUES (1, 'happy');
+    `)
+
+	writeFile("schema.sql", `
+        CREATE TYPE mood AS ENUM ('happy', 'sad');
+        CREATE TABLE users (id bigint PRIMARY KEY, mood mood NOT NULL DEFAULT 'sad');
+    `)
+
+	out, err := testutils.Execute("./psqldef", "-Upostgres", databaseName, "-f", "schema.sql")
+	if err == nil {
+		t.Errorf("removing enum values without recreate_enums must be error, but successfully got: %s", out)
+	}
+	assertEquals(t, out, "removing or reordering values of enum type public.mood requires recreating it, which is enabled by recreate_enums in --config: CREATE TYPE mood AS ENUM ('happy', 'sad')\n")
+
+	writeFile("config.yml", "recreate_enums: true\n")
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		CREATE TYPE "public"."mood_new" AS ENUM ('happy', 'sad');
+		ALTER TABLE "public"."users" ALTER COLUMN "mood" DROP DEFAULT;
+		ALTER TABLE "public"."users" ALTER COLUMN "mood" TYPE "public"."mood_new" USING "mood"::text::"public"."mood_new";
+		DROP TYPE "public"."mood";
+		ALTER TYPE "public"."mood_new" RENAME TO "mood";
+		ALTER TABLE "public"."users" ALTER COLUMN "mood" SET DEFAULT 'sad';
+		`,
+	))
+
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestPsqldefAddEnumValueAsDefault(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL(`
+        CREATE TYPE mood AS ENUM ('sad', 'happy');
+        CREATE TABLE users (id bigint PRIMARY KEY, mood mood NOT NULL DEFAULT 'sad');
+    `)
+
+	// A new value is committed before it's used by the default
+	writeFile("schema.sql", `
+        CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');
+        CREATE TABLE users (id bigint PRIMARY KEY, mood mood NOT NULL DEFAULT 'ok');
+    `)
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER TYPE "public"."mood" ADD VALUE 'ok' BEFORE 'happy';
+		ALTER TABLE "public"."users" ALTER COLUMN "mood" SET DEFAULT 'ok';
+		`,
+	))
+
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestPsqldefConfigManagedRoles(t *testing.T) {
+	resetTestDatabase()
+	mustExecuteSQL("DROP ROLE IF EXISTS app_reader;")
+	mustExecuteSQL("DROP ROLE IF EXISTS app_writer;")
+	mustExecuteSQL("CREATE ROLE app_reader;")
+	mustExecuteSQL("CREATE ROLE app_writer;")
+
+	mustExecuteSQL(`
+        CREATE TABLE users (id bigint PRIMARY KEY, name text);
+        CREATE FUNCTION user_count() RETURNS bigint LANGUAGE sql AS 'SELECT count(*) FROM users';
+        GRANT SELECT, INSERT ON users TO app_reader;
+        GRANT SELECT ON users TO app_writer;
+    `)
+
+	writeFile("schema.sql", `
+        CREATE TABLE users (id bigint PRIMARY KEY, name text);
+        CREATE FUNCTION user_count() RETURNS bigint LANGUAGE sql AS 'SELECT count(*) FROM users';
+        GRANT SELECT ON users TO app_reader WITH GRANT OPTION;
+        GRANT USAGE ON SCHEMA public TO app_reader;
+        GRANT EXECUTE ON FUNCTION user_count() TO app_reader;
+        GRANT ALL ON users TO app_writer;
+    `)
+
+	// Privileges are left alone unless their roles are managed
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		-- Skipped: GRANT SELECT ON TABLE "public"."users" TO "app_reader" WITH GRANT OPTION;
+		-- Skipped: GRANT USAGE ON SCHEMA "public" TO "app_reader";
+		-- Skipped: GRANT EXECUTE ON FUNCTION "public"."user_count"() TO "app_reader";
+		-- Skipped: GRANT ALL ON TABLE "public"."users" TO "app_writer";
+		`,
+	))
+
+	// The reason names managed_roles
+	out := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--dry-run", "--output", "json")
+	if !strings.Contains(out, `"reason": "role app_writer is not in managed_roles"`) {
+		t.Errorf("expected the reason of skipped privileges, but got: %s", out)
+	}
+
+	writeFile("config.yml", "managed_roles: |\n  app_reader\n")
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		GRANT SELECT ON TABLE "public"."users" TO "app_reader" WITH GRANT OPTION;
+		GRANT USAGE ON SCHEMA "public" TO "app_reader";
+		GRANT EXECUTE ON FUNCTION "public"."user_count"() TO "app_reader";
+		-- Skipped: GRANT ALL ON TABLE "public"."users" TO "app_writer";
+		REVOKE INSERT ON TABLE "public"."users" FROM "app_reader";
+		`,
+	))
+
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+`-- Skipped: GRANT ALL ON TABLE "public"."users" TO "app_writer";`+"\n")
+
+	// Unmanaged privileges are not drift since they are not compared
+	out = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml", "--check")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestPsqldefConfigManagedRolesAndOwners(t *testing.T) {
+	resetTestDatabase()
+	mustExecuteSQL("DROP ROLE IF EXISTS app_owner;")
+	mustExecuteSQL("DROP ROLE IF EXISTS app_group;")
+	mustExecuteSQL("CREATE ROLE app_group;")
+	writeFile("config.yml", "managed_roles: |\n  app_owner\n")
+
+	writeFile("schema.sql", `
+        CREATE ROLE app_owner WITH LOGIN PASSWORD 'secret';
+        GRANT app_group TO app_owner;
+        CREATE TABLE users (id bigint PRIMARY KEY);
+        ALTER TABLE users OWNER TO app_owner;
+    `)
+	dryRun := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--dry-run")
+	assertEquals(t, dryRun, "-- dry run --\n"+stripHeredoc(`
+		-- Skipped: CREATE ROLE "app_owner" WITH LOGIN;
+		-- Skipped: GRANT "app_group" TO "app_owner";
+		CREATE TABLE users (id bigint PRIMARY KEY);
+		-- Skipped: ALTER TABLE "public"."users" OWNER TO "app_owner";
+		`,
+	))
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		CREATE ROLE "app_owner" WITH LOGIN;
+		GRANT "app_group" TO "app_owner";
+		CREATE TABLE users (id bigint PRIMARY KEY);
+		ALTER TABLE "public"."users" OWNER TO "app_owner";
+		`,
+	))
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+
+	// Passwords are never exported
+	export := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "--export", "--config", "config.yml")
+	assertEquals(t, export, stripHeredoc(`
+		CREATE ROLE "app_owner" WITH LOGIN;
+
+		GRANT "app_group" TO "app_owner";
+
+		CREATE TABLE "public"."users" (
+		    "id" bigint NOT NULL,
+		    CONSTRAINT users_pkey PRIMARY KEY ("id")
+		);
+
+		ALTER TABLE "public"."users" OWNER TO "app_owner";
+		`,
+	))
+
+	writeFile("schema.sql", `
+        CREATE ROLE app_owner WITH LOGIN CONNECTION LIMIT 10;
+        CREATE TABLE users (id bigint PRIMARY KEY);
+    `)
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER ROLE "app_owner" WITH CONNECTION LIMIT 10;
+		REVOKE "app_group" FROM "app_owner";
+		`,
+	))
+
+	// The role owning a table cannot be dropped, so the table is dropped together
+	writeFile("schema.sql", "")
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml", "--enable-drop")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		DROP TABLE "public"."users";
+		DROP ROLE "app_owner";
+		`,
+	))
+}
+
 func TestPsqldefHelp(t *testing.T) {
 	_, err := testutils.Execute("./psqldef", "--help")
 	if err != nil {
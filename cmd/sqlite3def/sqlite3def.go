@@ -19,15 +19,22 @@ var version string
 
 // Return parsed options and schema filename
 // TODO: Support `sqldef schema.sql -opt val...`
-func parseOptions(args []string) (database.Config, *sqldef.Options) {
+func parseOptions(args []string) (database.Config, *database.Config, *sqldef.Options) {
 	var opts struct {
-		File       []string `short:"f" long:"file" description:"Read desired SQL from the file, rather than stdin" value-name:"filename" default:"-"`
-		DryRun     bool     `long:"dry-run" description:"Don't run DDLs but just show them"`
-		Export     bool     `long:"export" description:"Just dump the current schema to stdout"`
-		EnableDrop bool     `long:"enable-drop" description:"Enable destructive changes such as DROP for TABLE, SCHEMA, ROLE, USER, FUNCTION, PROCEDURE, TRIGGER, VIEW, INDEX, SEQUENCE, TYPE"`
-		Config     string   `long:"config" description:"YAML file to specify: target_tables, skip_tables"`
-		Help       bool     `long:"help" description:"Show this help"`
-		Version    bool     `long:"version" description:"Show this version"`
+		DSN             string   `long:"dsn" description:"Connection URL like sqlite:///path/to/file, defaulting to $DATABASE_URL when no database is given" value-name:"url"`
+		File            []string `short:"f" long:"file" description:"Read desired SQL from the file, rather than stdin" value-name:"filename" default:"-"`
+		DesiredDatabase string   `long:"desired-database" description:"Use the schema of the database as the desired one, rather than SQL files" value-name:"filename"`
+		DryRun          bool     `long:"dry-run" description:"Don't run DDLs but just show them"`
+		Check           bool     `long:"check" description:"Check whether the current schema matches the desired one without running DDLs, exiting with 2 if not"`
+		Export          bool     `long:"export" description:"Just dump the current schema to stdout"`
+		Output          string   `long:"output" description:"Output format of --dry-run, --export and applied DDLs" value-name:"format" choice:"text" choice:"json" default:"text"`
+		RollbackOutput  string   `long:"rollback-output" description:"Write DDLs reverting the changes to the file, with warnings on data that cannot be restored" value-name:"filename"`
+		WriteMigration  string   `long:"write-migration" description:"Write migration files of --migration-format to the directory instead of running DDLs" value-name:"directory"`
+		MigrationFormat string   `long:"migration-format" description:"Format of --write-migration: golang-migrate, flyway, goose or dbmate" value-name:"format" default:"golang-migrate"`
+		EnableDrop      bool     `long:"enable-drop" description:"Enable destructive changes such as DROP for TABLE, SCHEMA, ROLE, USER, FUNCTION, PROCEDURE, TRIGGER, VIEW, INDEX, SEQUENCE, TYPE"`
+		Config          string   `long:"config" description:"YAML file to specify: target_tables, skip_tables, enable_drop, detect_renames"`
+		Help            bool     `long:"help" description:"Show this help"`
+		Version         bool     `long:"version" description:"Show this version"`
 	}
 
 	parser := flags.NewParser(&opts, flags.None)
@@ -50,7 +57,7 @@ func parseOptions(args []string) (database.Config, *sqldef.Options) {
 	desiredFiles := sqldef.ParseFiles(opts.File)
 
 	var desiredDDLs string
-	if !opts.Export {
+	if !opts.Export && opts.DesiredDatabase == "" {
 		desiredDDLs, err = sqldef.ReadFiles(desiredFiles)
 		if err != nil {
 			log.Fatalf("Failed to read '%v': %s", desiredFiles, err)
@@ -58,26 +65,38 @@ func parseOptions(args []string) (database.Config, *sqldef.Options) {
 	}
 
 	options := sqldef.Options{
-		DesiredDDLs: desiredDDLs,
-		DryRun:      opts.DryRun,
-		Export:      opts.Export,
-		EnableDrop:  opts.EnableDrop,
-		Config:      database.ParseGeneratorConfig(opts.Config),
+		DesiredDDLs:     desiredDDLs,
+		DryRun:          opts.DryRun,
+		Check:           opts.Check,
+		Export:          opts.Export,
+		Output:          opts.Output,
+		RollbackOutput:  opts.RollbackOutput,
+		WriteMigration:  opts.WriteMigration,
+		MigrationFormat: opts.MigrationFormat,
+		EnableDrop:      opts.EnableDrop,
+		Config:          database.ParseGeneratorConfig(opts.Config),
 	}
 
-	if len(args) == 0 {
+	dsn := opts.DSN
+	if dsn == "" && len(args) == 0 {
+		dsn = os.Getenv("DATABASE_URL")
+	}
+	if len(args) == 0 && dsn == "" {
 		fmt.Print("No database is specified!\n\n")
 		parser.WriteHelp(os.Stdout)
 		os.Exit(1)
-	} else if len(args) > 1 {
+	} else if len(args) > 1 || (len(args) == 1 && dsn != "") {
+		if dsn != "" {
+			args = append([]string{"--dsn"}, args...)
+		}
 		fmt.Printf("Multiple databases are given: %v\n\n", args)
 		parser.WriteHelp(os.Stdout)
 		os.Exit(1)
 	}
 	var databaseName string
-	if strings.HasSuffix(args[0], ".sql") {
+	if len(args) == 1 && strings.HasSuffix(args[0], ".sql") {
 		options.CurrentFile = args[0]
-	} else {
+	} else if len(args) == 1 {
 		databaseName = args[0]
 	}
 
@@ -87,11 +106,23 @@ func parseOptions(args []string) (database.Config, *sqldef.Options) {
 	if _, err := os.Stat(config.Host); !os.IsNotExist(err) {
 		config.Socket = config.Host
 	}
-	return config, &options
+	if dsn != "" {
+		if err := database.ParseDSN(&config, dsn, "sqlite", "sqlite3"); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var desiredConfig *database.Config
+	if opts.DesiredDatabase != "" {
+		desiredConfig = &database.Config{
+			DbName: opts.DesiredDatabase,
+		}
+	}
+	return config, desiredConfig, &options
 }
 
 func main() {
-	config, options := parseOptions(os.Args[1:])
+	config, desiredConfig, options := parseOptions(os.Args[1:])
 
 	var db database.Database
 	if len(options.CurrentFile) > 0 {
@@ -105,6 +136,15 @@ func main() {
 		defer db.Close()
 	}
 
+	if desiredConfig != nil {
+		desiredDB, err := sqlite3.NewDatabase(*desiredConfig)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer desiredDB.Close()
+		options.DesiredDatabase = desiredDB
+	}
+
 	sqlParser := database.NewParser(parser.ParserModeSQLite3)
 	sqldef.Run(schema.GeneratorModeSQLite3, db, sqlParser, options)
 }
@@ -6,17 +6,22 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/sqldef/sqldef/v2"
 	"github.com/sqldef/sqldef/v2/cmd/testutils"
 	"github.com/sqldef/sqldef/v2/database"
 	"github.com/sqldef/sqldef/v2/database/sqlite3"
 	"github.com/sqldef/sqldef/v2/parser"
 	"github.com/sqldef/sqldef/v2/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -143,6 +148,52 @@ func TestSQLite3defConfigIncludesSkipTables(t *testing.T) {
 	assertEquals(t, apply, nothingModified)
 }
 
+func TestSQLite3defConfigEnableDrop(t *testing.T) {
+	resetTestDatabase()
+
+	usersTable := "CREATE TABLE users (id bigint, name text);"
+	testutils.MustExecute("sqlite3", "sqlite3def_test", usersTable+"CREATE INDEX index_name ON users(name); CREATE TABLE logs (id bigint);")
+
+	writeFile("schema.sql", usersTable)
+	writeFile("config.yml", "enable_drop: |\n  index\n")
+
+	apply := assertedExecute(t, "./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, apply, applyPrefix+"DROP INDEX `index_name`;\n-- Skipped: DROP TABLE `logs`;\n")
+
+	writeFile("config.yml", "enable_drop: |\n  indexes\n")
+	out, err := testutils.Execute("./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assert.Error(t, err)
+	assertEquals(t, out, "unknown object 'indexes' in enable_drop (expected one of: attribute, column, event, function, index, partition, role, sequence, table, trigger, type, view)\n")
+}
+
+func TestSQLite3defConfigDetectRenames(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id bigint, name text);")
+
+	writeFile("schema.sql", "CREATE TABLE users (id bigint, full_name text);")
+	dryRun := assertedExecute(t, "./sqlite3def", "--dry-run", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, dryRun, "-- dry run --\nALTER TABLE `users` ADD COLUMN `full_name` text;\n-- Skipped: ALTER TABLE `users` DROP COLUMN `name`;\n")
+
+	// A guessed rename is only suggested
+	writeFile("config.yml", "detect_renames: true\n")
+	dryRun = assertedExecute(t, "./sqlite3def", "--dry-run", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, dryRun, "-- dry run --\n-- Skipped: ALTER TABLE `users` RENAME COLUMN `name` TO `full_name`;\nALTER TABLE `users` ADD COLUMN `full_name` text;\n-- Skipped: ALTER TABLE `users` DROP COLUMN `name`;\n")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint,
+		  full_name text -- @renamed from name
+		);
+	`))
+	apply := assertedExecute(t, "./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, apply, applyPrefix+"ALTER TABLE `users` RENAME COLUMN `name` TO `full_name`;\n")
+
+	// A rename is not guessed when the type differs
+	writeFile("schema.sql", "CREATE TABLE users (id bigint, nickname integer);")
+	dryRun = assertedExecute(t, "./sqlite3def", "--dry-run", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, dryRun, "-- dry run --\nALTER TABLE `users` ADD COLUMN `nickname` integer;\n-- Skipped: ALTER TABLE `users` DROP COLUMN `full_name`;\n")
+}
+
 func TestSQLite3defVirtualTable(t *testing.T) {
 	resetTestDatabase()
 
@@ -354,6 +405,333 @@ func TestSQLite3defChangeTrigger(t *testing.T) {
 	assertApplyOutput(t, createTable+changeTrigger, nothingModified)
 }
 
+func TestSQLite3defPlanAndApply(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY,
+		    age integer
+		);
+		CREATE TABLE logs (
+		    id integer NOT NULL PRIMARY KEY
+		);`,
+	))
+
+	db, err := connectDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	sqlParser := database.NewParser(parser.ParserModeSQLite3)
+	options := &sqldef.Options{
+		DesiredDDLs: "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, age integer, name text);",
+	}
+
+	result, err := sqldef.Plan(ctx, schema.GeneratorModeSQLite3, db, sqlParser, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"ALTER TABLE `users` ADD COLUMN `name` text", "DROP TABLE `logs`"}, result.DDLs())
+	if assert.Len(t, result.Skipped, 1) {
+		assert.Equal(t, "DROP TABLE `logs`", result.Skipped[0].DDL)
+	}
+
+	addColumn := result.Changes[0]
+	assert.Equal(t, schema.ChangeAddColumn, addColumn.Kind)
+	assert.Equal(t, "users", addColumn.Table)
+	assert.Equal(t, "name", addColumn.Name)
+	assert.False(t, addColumn.Destructive)
+	assert.True(t, addColumn.Transactional)
+	assert.Equal(t, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, age integer, name text)", addColumn.Source)
+
+	dropTable := result.Changes[1]
+	assert.Equal(t, schema.ChangeDropTable, dropTable.Kind)
+	assert.Equal(t, "logs", dropTable.Table)
+	assert.True(t, dropTable.Destructive)
+	assert.Equal(t, "", dropTable.Source)
+
+	result, err = sqldef.Apply(ctx, schema.GeneratorModeSQLite3, db, sqlParser, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, result.Skipped, 1)
+
+	result, err = sqldef.Plan(ctx, schema.GeneratorModeSQLite3, db, sqlParser, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"DROP TABLE `logs`"}, result.DDLs())
+
+	_, err = sqldef.Plan(ctx, schema.GeneratorModeSQLite3, db, sqlParser, &sqldef.Options{DesiredDDLs: "CREATE TABLE"})
+	var generateErr *sqldef.GenerateError
+	assert.ErrorAs(t, err, &generateErr)
+
+	_, err = sqldef.Apply(ctx, schema.GeneratorModeSQLite3, db, sqlParser, &sqldef.Options{
+		DesiredDDLs: "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, age integer, name text);",
+		EnableDrop:  true,
+		BeforeApply: "SELECT * FROM no_such_table",
+	})
+	var applyErr *sqldef.ApplyError
+	if assert.ErrorAs(t, err, &applyErr) {
+		assert.Equal(t, "SELECT * FROM no_such_table", applyErr.DDL)
+	}
+}
+
+func TestSQLite3defCheck(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY
+		);
+		CREATE TABLE logs (
+		    id integer NOT NULL PRIMARY KEY
+		);`,
+	))
+
+	writeFile("schema.sql", "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text);")
+	out, err := testutils.Execute("./sqlite3def", "sqlite3def_test", "--check", "--file", "schema.sql")
+	var exitErr *exec.ExitError
+	if assert.ErrorAs(t, err, &exitErr) {
+		assert.Equal(t, sqldef.ExitCodeDrift, exitErr.ExitCode())
+	}
+	assertEquals(t, out, stripHeredoc(`
+		-- Drift detected --
+		users:
+		  add_column: ALTER TABLE `+"`users`"+` ADD COLUMN `+"`name`"+` text;
+		logs:
+		  drop_table: DROP TABLE `+"`logs`"+`;
+		`,
+	))
+
+	// --check never applies DDLs
+	_, err = testutils.Execute("./sqlite3def", "sqlite3def_test", "--check", "--file", "schema.sql")
+	assert.ErrorAs(t, err, &exitErr)
+
+	writeFile("schema.sql", "CREATE TABLE users (id integer NOT NULL PRIMARY KEY); CREATE TABLE logs (id integer NOT NULL PRIMARY KEY);")
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--check", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestSQLite3defRollbackOutput(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id bigint, name text); CREATE TABLE logs (id bigint);")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint,
+		  full_name text, -- @renamed from name
+		  age integer
+		);`,
+	))
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--rollback-output", "rollback.sql", "--file", "schema.sql")
+	assertEquals(t, out, stripHeredoc(`
+		-- WARNING: the rollback drops data written after the migration: ALTER TABLE `+"`users`"+` DROP COLUMN `+"`age`"+`
+		-- dry run --
+		ALTER TABLE `+"`users`"+` RENAME COLUMN `+"`name`"+` TO `+"`full_name`"+`;
+		ALTER TABLE `+"`users`"+` ADD COLUMN `+"`age`"+` integer;
+		-- Skipped: DROP TABLE `+"`logs`"+`;
+		`,
+	))
+	// The skipped DROP TABLE is not reverted
+	assertEquals(t, readFile("rollback.sql"), stripHeredoc(`
+		-- WARNING: the rollback drops data written after the migration: ALTER TABLE `+"`users`"+` DROP COLUMN `+"`age`"+`
+		ALTER TABLE `+"`users`"+` RENAME COLUMN `+"`full_name`"+` TO `+"`name`"+`;
+		ALTER TABLE `+"`users`"+` DROP COLUMN `+"`age`"+`;
+		`,
+	))
+
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--enable-drop", "--rollback-output", "rollback.sql", "--file", "schema.sql")
+	assertEquals(t, readFile("rollback.sql"), stripHeredoc(`
+		-- WARNING: the rollback cannot restore data dropped by: DROP TABLE `+"`logs`"+`
+		-- WARNING: the rollback drops data written after the migration: ALTER TABLE `+"`users`"+` DROP COLUMN `+"`age`"+`
+		ALTER TABLE `+"`users`"+` RENAME COLUMN `+"`full_name`"+` TO `+"`name`"+`;
+		CREATE TABLE logs (id bigint);
+		ALTER TABLE `+"`users`"+` DROP COLUMN `+"`age`"+`;
+		`,
+	))
+
+	// Running the rollback restores the original schema
+	testutils.MustExecute("sqlite3", "sqlite3def_test", ".read rollback.sql")
+	writeFile("schema.sql", "CREATE TABLE users (id bigint, name text); CREATE TABLE logs (id bigint);")
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--enable-drop", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestSQLite3defWriteMigration(t *testing.T) {
+	resetTestDatabase()
+	defer os.RemoveAll("migrations")
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id bigint);")
+
+	writeFile("schema.sql", "CREATE TABLE users (id bigint, name text);")
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--write-migration", "migrations", "--file", "schema.sql")
+	paths, _ := filepath.Glob("migrations/*_sqldef.*.sql")
+	if assert.Len(t, paths, 2) {
+		assert.Regexp(t, `^migrations/\d{14}_sqldef\.down\.sql$`, paths[0])
+		assertEquals(t, out, "-- Write migration --\n"+paths[1]+"\n"+paths[0]+"\n")
+		assertEquals(t, readFile(paths[1]), "ALTER TABLE `users` ADD COLUMN `name` text;\n")
+		assertEquals(t, readFile(paths[0]), stripHeredoc(`
+			-- WARNING: the rollback drops data written after the migration: ALTER TABLE `+"`users`"+` DROP COLUMN `+"`name`"+`
+			ALTER TABLE `+"`users`"+` DROP COLUMN `+"`name`"+`;
+			`,
+		))
+	}
+	// DDLs are not applied
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--export")
+	assertEquals(t, out, "CREATE TABLE users (id bigint);\n")
+
+	os.RemoveAll("migrations")
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--write-migration", "migrations", "--migration-format", "goose", "--file", "schema.sql")
+	paths, _ = filepath.Glob("migrations/*_sqldef.sql")
+	if assert.Len(t, paths, 1) {
+		assertEquals(t, readFile(paths[0]), stripHeredoc(`
+			-- +goose Up
+			ALTER TABLE `+"`users`"+` ADD COLUMN `+"`name`"+` text;
+
+			-- +goose Down
+			-- WARNING: the rollback drops data written after the migration: ALTER TABLE `+"`users`"+` DROP COLUMN `+"`name`"+`
+			ALTER TABLE `+"`users`"+` DROP COLUMN `+"`name`"+`;
+			`,
+		))
+	}
+
+	os.RemoveAll("migrations")
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--write-migration", "migrations", "--migration-format", "dbmate", "--file", "schema.sql")
+	paths, _ = filepath.Glob("migrations/*_sqldef.sql")
+	if assert.Len(t, paths, 1) {
+		assertEquals(t, readFile(paths[0]), stripHeredoc(`
+			-- migrate:up
+			ALTER TABLE `+"`users`"+` ADD COLUMN `+"`name`"+` text;
+
+			-- migrate:down
+			-- WARNING: the rollback drops data written after the migration: ALTER TABLE `+"`users`"+` DROP COLUMN `+"`name`"+`
+			ALTER TABLE `+"`users`"+` DROP COLUMN `+"`name`"+`;
+			`,
+		))
+	}
+
+	// No migration is written when every change is skipped
+	os.RemoveAll("migrations")
+	writeFile("schema.sql", "")
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--write-migration", "migrations", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified+"-- Skipped: DROP TABLE `users`;\n")
+	assert.NoDirExists(t, "migrations")
+
+	out, err := testutils.Execute("./sqlite3def", "sqlite3def_test", "--write-migration", "migrations", "--migration-format", "liquibase", "--file", "schema.sql")
+	assert.Error(t, err)
+	assert.Contains(t, out, "unknown migration format 'liquibase' (expected one of: golang-migrate, flyway, goose, dbmate)")
+}
+
+func TestSQLite3defDesiredDatabase(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("rm", "-f", "sqlite3def_desired")
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id bigint); CREATE TABLE logs (id bigint);")
+	testutils.MustExecute("sqlite3", "sqlite3def_desired", "CREATE TABLE users (id bigint, name text);")
+
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--desired-database", "sqlite3def_desired")
+	assertEquals(t, out, "-- dry run --\nALTER TABLE `users` ADD COLUMN `name` text;\n-- Skipped: DROP TABLE `logs`;\n")
+
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--enable-drop", "--desired-database", "sqlite3def_desired")
+	assertEquals(t, out, applyPrefix+"ALTER TABLE `users` ADD COLUMN `name` text;\nDROP TABLE `logs`;\n")
+
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--check", "--desired-database", "sqlite3def_desired")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestSQLite3defDSN(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id bigint);")
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := assertedExecute(t, "./sqlite3def", "--dsn", "sqlite://"+filepath.Join(cwd, "sqlite3def_test"), "--export")
+	assertEquals(t, out, "CREATE TABLE users (id bigint);\n")
+
+	out = assertedExecute(t, "./sqlite3def", "--dsn", "sqlite:sqlite3def_test?_pragma=busy_timeout(5000)", "--export")
+	assertEquals(t, out, "CREATE TABLE users (id bigint);\n")
+
+	cmd := exec.Command("./sqlite3def", "--export")
+	cmd.Env = append(os.Environ(), "DATABASE_URL=sqlite:sqlite3def_test")
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err)
+	assertEquals(t, string(output), "CREATE TABLE users (id bigint);\n")
+
+	out, err = testutils.Execute("./sqlite3def", "--dsn", "postgres://localhost/sqlite3def_test", "--export")
+	assert.Error(t, err)
+	assert.Contains(t, out, "unsupported scheme 'postgres' in the connection URL (expected one of: sqlite, sqlite3)")
+}
+
+func TestSQLite3defOutputJSON(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY
+		);
+		CREATE TABLE logs (
+		    id integer NOT NULL PRIMARY KEY
+		);`,
+	))
+	writeFile("schema.sql", "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text);")
+
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--output=json", "--file", "schema.sql")
+	assertEquals(t, out, stripHeredoc(`
+		{
+		  "dry_run": true,
+		  "statements": [
+		    {
+		      "ddl": "ALTER TABLE `+"`users`"+` ADD COLUMN `+"`name`"+` text",
+		      "kind": "add_column",
+		      "table": "users",
+		      "name": "name",
+		      "destructive": false,
+		      "transactional": true,
+		      "source": "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text)"
+		    }
+		  ],
+		  "skipped": [
+		    {
+		      "ddl": "DROP TABLE `+"`logs`"+`",
+		      "kind": "drop_table",
+		      "table": "logs",
+		      "name": "logs",
+		      "destructive": true,
+		      "transactional": true,
+		      "reason": "dropping table requires --enable-drop or enable_drop: table"
+		    }
+		  ]
+		}
+		`,
+	))
+
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--export", "--output=json")
+	assertEquals(t, out, stripHeredoc(`
+		{
+		  "statements": [
+		    {
+		      "ddl": "CREATE TABLE users (\n    id integer NOT NULL PRIMARY KEY\n)",
+		      "kind": "create_table",
+		      "table": "users",
+		      "name": "users",
+		      "destructive": false,
+		      "transactional": true
+		    },
+		    {
+		      "ddl": "CREATE TABLE logs (\n    id integer NOT NULL PRIMARY KEY\n)",
+		      "kind": "create_table",
+		      "table": "logs",
+		      "name": "logs",
+		      "destructive": false,
+		      "transactional": true
+		    }
+		  ]
+		}
+		`,
+	))
+}
+
 func TestSQLite3defHelp(t *testing.T) {
 	_, err := testutils.Execute("./sqlite3def", "--help")
 	if err != nil {
@@ -374,6 +752,8 @@ func TestMain(m *testing.M) {
 	_ = os.Remove("sqlite3def_test")
 	_ = os.Remove("schema.sql")
 	_ = os.Remove("config.yml")
+	_ = os.Remove("rollback.sql")
+	_ = os.Remove("sqlite3def_desired")
 	os.Exit(status)
 }
 
@@ -431,6 +811,14 @@ func writeFile(path string, content string) {
 	file.Write(([]byte)(content))
 }
 
+func readFile(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return string(content)
+}
+
 func stripHeredoc(heredoc string) string {
 	heredoc = strings.TrimPrefix(heredoc, "\n")
 	re := regexp.MustCompilePOSIX("^\t*")
@@ -92,7 +92,7 @@ func RunTest(t *testing.T, db database.Database, test TestCase, mode schema.Gene
 		t.Fatal(err)
 	}
 	if len(ddls) > 0 {
-		t.Errorf("expected nothing is modified, but got:\n```\n%s```", joinDDLs(ddls))
+		t.Errorf("expected nothing is modified, but got:\n```\n%s```", joinDDLs(schema.ChangeDDLs(ddls)))
 	}
 
 	// Main test
@@ -105,11 +105,11 @@ func RunTest(t *testing.T, db database.Database, test TestCase, mode schema.Gene
 		t.Fatal(err)
 	}
 	expected := *test.Output
-	actual := joinDDLs(ddls)
+	actual := joinDDLs(schema.ChangeDDLs(ddls))
 	if expected != actual {
 		t.Errorf("\nexpected:\n```\n%s```\n\nactual:\n```\n%s```", expected, actual)
 	}
-	err = runDDLs(db, ddls)
+	err = runDDLs(db, changeStatements(ddls))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -124,7 +124,7 @@ func RunTest(t *testing.T, db database.Database, test TestCase, mode schema.Gene
 		t.Fatal(err)
 	}
 	if len(ddls) > 0 {
-		t.Errorf("expected nothing is modified, but got:\n```\n%s```", joinDDLs(ddls))
+		t.Errorf("expected nothing is modified, but got:\n```\n%s```", joinDDLs(schema.ChangeDDLs(ddls)))
 	}
 }
 
@@ -160,30 +160,38 @@ func compareVersion(t *testing.T, leftVersion string, rightVersion string) int {
 	return 0
 }
 
-func splitDDLs(mode schema.GeneratorMode, sqlParser database.Parser, str string, defaultSchema string) ([]string, error) {
+func splitDDLs(mode schema.GeneratorMode, sqlParser database.Parser, str string, defaultSchema string) ([]database.Statement, error) {
 	statements, err := schema.ParseDDLs(mode, sqlParser, str, defaultSchema)
 	if err != nil {
 		return nil, err
 	}
 
-	var ddls []string
+	var ddls []database.Statement
 	for _, statement := range statements {
-		ddls = append(ddls, statement.Statement())
+		ddls = append(ddls, database.Statement{DDL: statement.Statement(), Transactional: database.TransactionSupported(statement.Statement())})
 	}
 	return ddls, nil
 }
 
-func runDDLs(db database.Database, ddls []string) error {
+func changeStatements(changes []schema.Change) []database.Statement {
+	var statements []database.Statement
+	for _, change := range changes {
+		statements = append(statements, database.Statement{DDL: change.DDL, Transactional: change.Transactional})
+	}
+	return statements
+}
+
+func runDDLs(db database.Database, statements []database.Statement) error {
 	transaction, err := db.DB().Begin()
 	if err != nil {
 		return err
 	}
-	for _, ddl := range ddls {
+	for _, statement := range statements {
 		var err error
-		if database.TransactionSupported(ddl) {
-			_, err = transaction.Exec(ddl)
+		if statement.Transactional {
+			_, err = transaction.Exec(statement.DDL)
 		} else {
-			_, err = db.DB().Exec(ddl)
+			_, err = db.DB().Exec(statement.DDL)
 		}
 		if err != nil {
 			rollbackErr := transaction.Rollback()
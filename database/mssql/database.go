@@ -696,7 +696,12 @@ func (d *MssqlDatabase) GetDefaultSchema() string {
 
 func mssqlBuildDSN(config database.Config) string {
 	query := url.Values{}
-	query.Add("database", config.DbName)
+	for key, values := range config.Params {
+		query[key] = values
+	}
+	if config.DbName != "" || !query.Has("database") {
+		query.Set("database", config.DbName)
+	}
 
 	u := &url.URL{
 		Scheme:   "sqlserver",
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 
 	driver "github.com/go-sql-driver/mysql"
@@ -66,6 +67,18 @@ func (d *MysqlDatabase) DumpDDLs() (string, error) {
 	}
 	ddls = append(ddls, triggerDDLs...)
 
+	routineDDLs, err := d.routines()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, routineDDLs...)
+
+	eventDDLs, err := d.events()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, eventDDLs...)
+
 	return strings.Join(ddls, "\n\n"), nil
 }
 
@@ -145,6 +158,99 @@ func (d *MysqlDatabase) triggers() ([]string, error) {
 	return ddls, nil
 }
 
+func (d *MysqlDatabase) routines() ([]string, error) {
+	rows, err := d.db.Query("select ROUTINE_TYPE, ROUTINE_NAME from INFORMATION_SCHEMA.ROUTINES where ROUTINE_SCHEMA = ? order by ROUTINE_TYPE, ROUTINE_NAME", d.config.DbName)
+	if err != nil {
+		return nil, err
+	}
+	var routineTypes, routineNames []string
+	for rows.Next() {
+		var routineType, routineName string
+		if err = rows.Scan(&routineType, &routineName); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		routineTypes = append(routineTypes, routineType)
+		routineNames = append(routineNames, routineName)
+	}
+	rows.Close()
+
+	var ddls []string
+	for i, routineName := range routineNames {
+		ddl, err := d.showCreate(routineTypes[i], routineName)
+		if err != nil {
+			return nil, err
+		}
+		ddls = append(ddls, ddl)
+	}
+	return ddls, nil
+}
+
+func (d *MysqlDatabase) events() ([]string, error) {
+	rows, err := d.db.Query("select EVENT_NAME from INFORMATION_SCHEMA.EVENTS where EVENT_SCHEMA = ? order by EVENT_NAME", d.config.DbName)
+	if err != nil {
+		return nil, err
+	}
+	var eventNames []string
+	for rows.Next() {
+		var eventName string
+		if err = rows.Scan(&eventName); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		eventNames = append(eventNames, eventName)
+	}
+	rows.Close()
+
+	var ddls []string
+	for _, eventName := range eventNames {
+		ddl, err := d.showCreate("EVENT", eventName)
+		if err != nil {
+			return nil, err
+		}
+		ddls = append(ddls, ddl)
+	}
+	return ddls, nil
+}
+
+var definerClause = regexp.MustCompile(`^CREATE\s+DEFINER\s*=\s*\S+\s+`)
+
+// Return the DDL of a PROCEDURE, a FUNCTION or an EVENT by `SHOW CREATE` without DEFINER, which depends on the environment
+func (d *MysqlDatabase) showCreate(object string, name string) (string, error) {
+	rows, err := d.db.Query(fmt.Sprintf("show create %s `%s`", strings.ToLower(object), name)) // TODO: escape name
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+	values := make([]sql.NullString, len(columns))
+	dests := make([]any, len(columns))
+	for i := range values {
+		dests[i] = &values[i]
+	}
+	if !rows.Next() {
+		return "", fmt.Errorf("%s `%s` is not found by SHOW CREATE %s", strings.ToLower(object), name, object)
+	}
+	if err = rows.Scan(dests...); err != nil {
+		return "", err
+	}
+
+	for i, column := range columns {
+		if !strings.EqualFold(column, "Create "+object) {
+			continue
+		}
+		if !values[i].Valid { // NULL when the user doesn't have the privilege to see it
+			return "", fmt.Errorf("the definition of %s `%s` is not visible to the user", strings.ToLower(object), name)
+		}
+		return definerClause.ReplaceAllString(values[i].String, "CREATE ") + ";", nil
+	}
+	return "", fmt.Errorf("unexpected columns of SHOW CREATE %s: %s", object, strings.Join(columns, ", "))
+}
+
 func (d *MysqlDatabase) DB() *sql.DB {
 	return d.db
 }
@@ -171,7 +277,16 @@ func mysqlBuildDSN(config database.Config) string {
 		c.Net = "unix"
 		c.Addr = config.Socket
 	}
-	return c.FormatDSN()
+	dsn := c.FormatDSN()
+	if len(config.Params) > 0 {
+		// Append them to the DSN rather than c.Params so that the driver parses known ones like `timeout`
+		if strings.Contains(dsn, "?") {
+			dsn += "&" + config.Params.Encode()
+		} else {
+			dsn += "?" + config.Params.Encode()
+		}
+	}
+	return dsn
 }
 
 func registerTLSConfig(pemPath string) error {
@@ -0,0 +1,736 @@
+package mysql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sqldef/sqldef/v2/database"
+	"github.com/sqldef/sqldef/v2/parser"
+)
+
+type MysqlParser struct {
+	parser database.GenericParser
+}
+
+var _ database.Parser = (*MysqlParser)(nil)
+
+func NewParser() MysqlParser {
+	return MysqlParser{
+		parser: database.NewParser(parser.ParserModeMysql),
+	}
+}
+
+// Parse stored procedures, functions and events here since the generic parser doesn't support their bodies.
+// `PARTITION BY` of CREATE TABLE is also parsed here. Other statements are passed to the generic parser as they are.
+func (p MysqlParser) Parse(sql string) ([]database.DDLStatement, error) {
+	var result []database.DDLStatement
+	tokens := tokenize(sql)
+	genericStart := 0 // Position of the statements that are not parsed yet
+
+	for i := 0; i < len(tokens); {
+		var parse func(string, []token) (database.DDLStatement, error)
+		end := statementEnd(tokens, i, false)
+		if isRoutineStart(tokens[i:]) {
+			parse = parseRoutine
+			end = statementEnd(tokens, i, true)
+		} else if partitionStart(tokens[i:end]) >= 0 {
+			parse = p.parsePartitionedTable
+		} else {
+			i = end + 1
+			continue
+		}
+
+		stmts, err := p.parser.Parse(sql[genericStart:tokens[i].pos])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, stmts...)
+
+		stmt, err := parse(sql, tokens[i:end])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, stmt)
+
+		if end < len(tokens) {
+			genericStart = tokens[end].end
+		} else {
+			genericStart = len(sql)
+		}
+		i = end + 1
+	}
+
+	stmts, err := p.parser.Parse(sql[genericStart:])
+	if err != nil {
+		return nil, err
+	}
+	return append(result, stmts...), nil
+}
+
+type tokenKind int
+
+const (
+	wordToken   = tokenKind(iota)
+	stringToken // '...' or "..."
+	identToken  // `...`
+	symbolToken
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+	end  int
+}
+
+func (t token) is(symbol string) bool {
+	return t.kind == symbolToken && t.text == symbol
+}
+
+func (t token) isKeyword(keywords ...string) bool {
+	if t.kind != wordToken {
+		return false
+	}
+	for _, keyword := range keywords {
+		if strings.EqualFold(t.text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// Split sql into words, quoted strings, quoted identifiers and symbols. Whitespaces and comments are skipped
+// except the content of `/*!50100 ... */`, which is executed by MySQL.
+func tokenize(sql string) []token {
+	var tokens []token
+	inVersionComment := false
+	for i := 0; i < len(sql); {
+		start := i
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case strings.HasPrefix(sql[i:], "/*!"):
+			i += 3
+			for i < len(sql) && sql[i] >= '0' && sql[i] <= '9' {
+				i++ // version
+			}
+			inVersionComment = true
+			continue
+		case inVersionComment && strings.HasPrefix(sql[i:], "*/"):
+			i += 2
+			inVersionComment = false
+			continue
+		case c == '#' || strings.HasPrefix(sql[i:], "-- ") || strings.HasPrefix(sql[i:], "--\t") || strings.HasPrefix(sql[i:], "--\n") || sql[i:] == "--":
+			if end := strings.IndexByte(sql[i:], '\n'); end >= 0 {
+				i += end + 1
+			} else {
+				i = len(sql)
+			}
+			continue
+		case strings.HasPrefix(sql[i:], "/*"):
+			if end := strings.Index(sql[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(sql)
+			}
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			for i++; i < len(sql); i++ {
+				if sql[i] == '\\' && c != '`' {
+					i++
+				} else if sql[i] == c {
+					if i+1 < len(sql) && sql[i+1] == c {
+						i++ // Escaped by doubling
+					} else {
+						break
+					}
+				}
+			}
+			i = min(i+1, len(sql))
+			kind := stringToken
+			if c == '`' {
+				kind = identToken
+			}
+			tokens = append(tokens, token{kind: kind, text: sql[start:i], pos: start, end: i})
+		case isWordChar(c):
+			for i < len(sql) && isWordChar(sql[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: wordToken, text: sql[start:i], pos: start, end: i})
+		default:
+			i++
+			tokens = append(tokens, token{kind: symbolToken, text: sql[start:i], pos: start, end: i})
+		}
+	}
+	return tokens
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '$' || c >= 0x80
+}
+
+// Return the index of `;` terminating the statement starting at tokens[i], or len(tokens) if it's missing.
+// When blocks is true, `;` in `BEGIN ... END` and `CASE ... END CASE` of stored programs doesn't terminate it.
+// IF, LOOP, REPEAT and WHILE statements are expected to be in `BEGIN ... END`.
+func statementEnd(tokens []token, i int, blocks bool) int {
+	depth := 0
+	for ; i < len(tokens); i++ {
+		if tokens[i].is(";") && depth <= 0 {
+			return i
+		}
+		if !blocks {
+			continue
+		}
+
+		if tokens[i].isKeyword("BEGIN", "CASE") {
+			depth++
+		} else if tokens[i].isKeyword("END") {
+			if i+1 < len(tokens) && tokens[i+1].isKeyword("IF", "LOOP", "REPEAT", "WHILE") {
+				i++ // The beginning of them is not counted
+				continue
+			}
+			if i+1 < len(tokens) && tokens[i+1].isKeyword("CASE") {
+				i++
+			}
+			depth--
+		}
+	}
+	return i
+}
+
+type tokenReader struct {
+	sql    string
+	tokens []token
+	i      int
+}
+
+func (r *tokenReader) peek() token {
+	if r.i < len(r.tokens) {
+		return r.tokens[r.i]
+	}
+	return token{kind: symbolToken, pos: len(r.sql), end: len(r.sql)}
+}
+
+func (r *tokenReader) next() token {
+	t := r.peek()
+	r.i++
+	return t
+}
+
+// Consume the keywords if all of them follow in order
+func (r *tokenReader) acceptKeywords(keywords ...string) bool {
+	for j, keyword := range keywords {
+		if r.i+j >= len(r.tokens) || !r.tokens[r.i+j].isKeyword(keyword) {
+			return false
+		}
+	}
+	r.i += len(keywords)
+	return true
+}
+
+func (r *tokenReader) acceptSymbol(symbol string) bool {
+	if r.peek().is(symbol) {
+		r.i++
+		return true
+	}
+	return false
+}
+
+// Skip `DEFINER = user` of stored programs
+func (r *tokenReader) skipDefiner() {
+	if !r.acceptKeywords("DEFINER") {
+		return
+	}
+	r.acceptSymbol("=")
+	r.next()
+	for r.acceptSymbol("@") || r.acceptSymbol(".") {
+		r.next()
+	}
+	if r.peek().is("(") { // CURRENT_USER()
+		r.next()
+		r.acceptSymbol(")")
+	}
+}
+
+// Return the tokens until the `)` closing the consumed `(`, which is consumed as well
+func (r *tokenReader) group() []token {
+	start := r.i
+	for depth := 1; r.i < len(r.tokens); r.i++ {
+		if r.tokens[r.i].is("(") {
+			depth++
+		} else if r.tokens[r.i].is(")") {
+			if depth--; depth == 0 {
+				r.i++
+				return r.tokens[start : r.i-1]
+			}
+		}
+	}
+	return r.tokens[start:]
+}
+
+// Return the text from the current token to the end of the statement
+func (r *tokenReader) rest() string {
+	if r.i >= len(r.tokens) {
+		return ""
+	}
+	return r.sql[r.tokens[r.i].pos:r.tokens[len(r.tokens)-1].end]
+}
+
+func (r *tokenReader) unexpected(object string) error {
+	t := r.peek()
+	if t.text == "" {
+		return fmt.Errorf("unexpected end of %s", object)
+	}
+	return fmt.Errorf("unexpected '%s' at position %d of %s", t.text, t.pos, object)
+}
+
+func isRoutineStart(tokens []token) bool {
+	r := &tokenReader{tokens: tokens}
+	if !r.acceptKeywords("CREATE") {
+		return false
+	}
+	r.skipDefiner()
+	return r.peek().isKeyword("PROCEDURE", "FUNCTION", "EVENT")
+}
+
+// Parse CREATE PROCEDURE, CREATE FUNCTION or CREATE EVENT. Their Definition is normalized so that the one
+// written by users can be compared to the one of `SHOW CREATE`, which omits default options and DEFINER.
+func parseRoutine(sql string, tokens []token) (database.DDLStatement, error) {
+	r := &tokenReader{sql: sql, tokens: tokens}
+	r.acceptKeywords("CREATE")
+	r.skipDefiner()
+	object := strings.ToLower(r.next().text)
+	r.acceptKeywords("IF", "NOT", "EXISTS")
+
+	name := parser.TableName{Name: parser.NewTableIdent(identValue(r.next()))}
+	if r.acceptSymbol(".") {
+		name = parser.TableName{Schema: name.Name, Name: parser.NewTableIdent(identValue(r.next()))}
+	}
+
+	var stmt *parser.DDL
+	if object == "event" {
+		event, err := parseEvent(r, name)
+		if err != nil {
+			return database.DDLStatement{}, err
+		}
+		stmt = &parser.DDL{Action: parser.CreateEvent, Event: event}
+	} else {
+		function, err := parseFunction(r, name, object == "procedure")
+		if err != nil {
+			return database.DDLStatement{}, err
+		}
+		stmt = &parser.DDL{Action: parser.CreateFunction, Function: function}
+	}
+
+	return database.DDLStatement{
+		DDL:       sql[tokens[0].pos:tokens[len(tokens)-1].end],
+		Statement: stmt,
+	}, nil
+}
+
+func parseFunction(r *tokenReader, name parser.TableName, procedure bool) (*parser.Function, error) {
+	object := "function"
+	if procedure {
+		object = "procedure"
+	}
+
+	if !r.acceptSymbol("(") {
+		return nil, r.unexpected(object)
+	}
+	definition := []string{strings.ToUpper(object), "(" + normalizeTokens(r.group()) + ")"}
+
+	var returns string
+	if !procedure {
+		if !r.acceptKeywords("RETURNS") {
+			return nil, r.unexpected(object)
+		}
+		returns = r.dataType()
+		definition = append(definition, "RETURNS", returns)
+	}
+
+	deterministic := "NOT DETERMINISTIC"
+	dataAccess := "CONTAINS SQL"
+	security := "DEFINER"
+	var comment string
+characteristics:
+	for {
+		switch {
+		case r.acceptKeywords("COMMENT"):
+			comment = stringValue(r.next())
+		case r.acceptKeywords("LANGUAGE", "SQL"):
+		case r.acceptKeywords("NOT", "DETERMINISTIC"):
+			deterministic = "NOT DETERMINISTIC"
+		case r.acceptKeywords("DETERMINISTIC"):
+			deterministic = "DETERMINISTIC"
+		case r.acceptKeywords("CONTAINS", "SQL"):
+			dataAccess = "CONTAINS SQL"
+		case r.acceptKeywords("NO", "SQL"):
+			dataAccess = "NO SQL"
+		case r.acceptKeywords("READS", "SQL", "DATA"):
+			dataAccess = "READS SQL DATA"
+		case r.acceptKeywords("MODIFIES", "SQL", "DATA"):
+			dataAccess = "MODIFIES SQL DATA"
+		case r.acceptKeywords("SQL", "SECURITY"):
+			security = strings.ToUpper(r.next().text)
+		default:
+			break characteristics
+		}
+	}
+
+	body := r.rest()
+	if body == "" {
+		return nil, r.unexpected(object)
+	}
+	definition = append(definition, deterministic, dataAccess, "SQL SECURITY", security, "COMMENT", fmt.Sprintf("%q", comment), normalizeBody(body))
+
+	return &parser.Function{
+		Name:       name,
+		Procedure:  procedure,
+		Returns:    returns,
+		Definition: strings.Join(definition, " "),
+	}, nil
+}
+
+func parseEvent(r *tokenReader, name parser.TableName) (*parser.Event, error) {
+	if !r.acceptKeywords("ON", "SCHEDULE") {
+		return nil, r.unexpected("event")
+	}
+	var schedule, starts []token
+	for r.i < len(r.tokens) && !r.peek().isKeyword("ON", "ENABLE", "DISABLE", "COMMENT", "DO") {
+		if r.acceptKeywords("STARTS") {
+			for r.i < len(r.tokens) && !r.peek().isKeyword("ENDS", "ON", "ENABLE", "DISABLE", "COMMENT", "DO") {
+				starts = append(starts, r.next())
+			}
+			continue
+		}
+		schedule = append(schedule, r.next())
+	}
+
+	completion := "NOT PRESERVE"
+	if r.acceptKeywords("ON", "COMPLETION", "PRESERVE") {
+		completion = "PRESERVE"
+	} else {
+		r.acceptKeywords("ON", "COMPLETION", "NOT", "PRESERVE")
+	}
+
+	status := "ENABLE"
+	if r.acceptKeywords("DISABLE", "ON", "SLAVE") || r.acceptKeywords("DISABLE", "ON", "REPLICA") {
+		status = "DISABLE ON REPLICA"
+	} else if r.acceptKeywords("DISABLE") {
+		status = "DISABLE"
+	} else {
+		r.acceptKeywords("ENABLE")
+	}
+
+	var comment string
+	if r.acceptKeywords("COMMENT") {
+		comment = stringValue(r.next())
+	}
+
+	if !r.acceptKeywords("DO") || r.rest() == "" {
+		return nil, r.unexpected("event")
+	}
+	definition := []string{"EVENT ON SCHEDULE", normalizeTokens(schedule), "ON COMPLETION", completion, status, "COMMENT", fmt.Sprintf("%q", comment), "DO", normalizeBody(r.rest())}
+
+	return &parser.Event{
+		Name:       name,
+		Starts:     normalizeTokens(starts),
+		Definition: strings.Join(definition, " "),
+	}, nil
+}
+
+// Return the index of `PARTITION BY` in CREATE TABLE, or -1 if the table isn't partitioned
+func partitionStart(tokens []token) int {
+	r := &tokenReader{tokens: tokens}
+	if !r.acceptKeywords("CREATE") {
+		return -1
+	}
+	r.acceptKeywords("TEMPORARY")
+	if !r.acceptKeywords("TABLE") {
+		return -1
+	}
+
+	depth := 0
+	for i := r.i; i+1 < len(tokens); i++ {
+		if tokens[i].is("(") {
+			depth++
+		} else if tokens[i].is(")") {
+			depth--
+		} else if depth == 0 && tokens[i].isKeyword("PARTITION") && tokens[i+1].isKeyword("BY") {
+			return i
+		}
+	}
+	return -1
+}
+
+// Parse CREATE TABLE with `PARTITION BY`, which is not supported by the generic parser
+func (p MysqlParser) parsePartitionedTable(sql string, tokens []token) (database.DDLStatement, error) {
+	k := partitionStart(tokens)
+	tableEnd := tokens[k].pos
+	if comment := strings.LastIndex(sql[:tableEnd], "/*!"); comment >= 0 && !strings.Contains(sql[comment:tableEnd], "*/") {
+		tableEnd = comment // `/*!50100 PARTITION BY ... */` of SHOW CREATE TABLE
+	}
+
+	stmts, err := p.parser.Parse(sql[tokens[0].pos:tableEnd])
+	if err != nil {
+		return database.DDLStatement{}, err
+	}
+	if len(stmts) != 1 {
+		return database.DDLStatement{}, fmt.Errorf("unexpected PARTITION BY in: %s", sql[tokens[0].pos:tableEnd])
+	}
+	stmt, ok := stmts[0].Statement.(*parser.DDL)
+	if !ok || stmt.Action != parser.CreateTable {
+		return database.DDLStatement{}, fmt.Errorf("unexpected PARTITION BY in: %s", stmts[0].DDL)
+	}
+
+	stmt.TableSpec.Partition, err = parsePartitionOption(sql, tokens[k:])
+	if err != nil {
+		return database.DDLStatement{}, err
+	}
+
+	end := tokens[len(tokens)-1].end
+	if rest := strings.TrimLeft(sql[end:], " \t\r\n"); strings.HasPrefix(rest, "*/") {
+		end = len(sql) - len(rest) + 2
+	}
+	return database.DDLStatement{
+		DDL:       sql[tokens[0].pos:end],
+		Statement: stmt,
+	}, nil
+}
+
+// Parse `PARTITION BY`. Its Scheme and the Values of partitions are normalized so that the ones written by users
+// can be compared to the ones of `SHOW CREATE TABLE`, which quotes columns and adds `ENGINE = InnoDB` to each partition.
+func parsePartitionOption(sql string, tokens []token) (*parser.PartitionOption, error) {
+	r := &tokenReader{sql: sql, tokens: tokens}
+	r.acceptKeywords("PARTITION", "BY")
+
+	scheme, partitionType, partitions, err := r.partitionMethod("PARTITIONS")
+	if err != nil {
+		return nil, err
+	}
+	if r.acceptKeywords("SUBPARTITION", "BY") {
+		subpartitionScheme, _, subpartitions, err := r.partitionMethod("SUBPARTITIONS")
+		if err != nil {
+			return nil, err
+		}
+		scheme += " subpartition by " + subpartitionScheme
+		if subpartitions > 0 {
+			scheme += fmt.Sprintf(" subpartitions %d", subpartitions)
+		}
+	}
+
+	var definitions []*parser.PartitionDefinition
+	if r.acceptSymbol("(") {
+		for _, definitionTokens := range splitByComma(r.group()) {
+			definition, err := parsePartitionDefinition(sql, definitionTokens)
+			if err != nil {
+				return nil, err
+			}
+			definitions = append(definitions, definition)
+		}
+	}
+	if r.i < len(r.tokens) {
+		return nil, r.unexpected("PARTITION BY")
+	}
+
+	if partitions == 0 {
+		partitions = len(definitions)
+	}
+	return &parser.PartitionOption{
+		Clause:      sql[tokens[0].pos:tokens[len(tokens)-1].end],
+		Type:        partitionType,
+		Scheme:      scheme,
+		Partitions:  partitions,
+		Definitions: definitions,
+	}, nil
+}
+
+// Parse `[LINEAR] HASH (expr)`, `[LINEAR] KEY [ALGORITHM = n] (columns)`, `RANGE [COLUMNS] (...)` or `LIST [COLUMNS] (...)`,
+// followed by the number of partitions specified by countKeyword.
+func (r *tokenReader) partitionMethod(countKeyword string) (string, string, int, error) {
+	start := r.i
+	r.acceptKeywords("LINEAR")
+	partitionType := strings.ToUpper(r.next().text)
+	switch partitionType {
+	case "RANGE", "LIST":
+		r.acceptKeywords("COLUMNS")
+	case "HASH":
+	case "KEY":
+		if r.acceptKeywords("ALGORITHM") {
+			r.acceptSymbol("=")
+			r.next()
+		}
+	default:
+		r.i--
+		return "", "", 0, r.unexpected("PARTITION BY")
+	}
+	if !r.acceptSymbol("(") {
+		return "", "", 0, r.unexpected("PARTITION BY")
+	}
+	r.group()
+	scheme := normalizeTokens(r.tokens[start:r.i])
+
+	var count int
+	if r.acceptKeywords(countKeyword) {
+		var err error
+		if count, err = strconv.Atoi(r.next().text); err != nil {
+			return "", "", 0, fmt.Errorf("invalid %s in PARTITION BY: %w", countKeyword, err)
+		}
+	}
+	return scheme, partitionType, count, nil
+}
+
+func parsePartitionDefinition(sql string, tokens []token) (*parser.PartitionDefinition, error) {
+	r := &tokenReader{sql: sql, tokens: tokens}
+	if !r.acceptKeywords("PARTITION") || r.i >= len(r.tokens) {
+		return nil, r.unexpected("partition definition")
+	}
+	name := identValue(r.next())
+
+	var values []token
+	for r.i < len(r.tokens) {
+		switch {
+		case r.peek().is("="):
+			r.next()
+		case r.acceptKeywords("STORAGE", "ENGINE"), r.acceptKeywords("ENGINE"):
+			r.acceptSymbol("=")
+			r.next() // The engine of a partition is the one of the table
+		case r.peek().is("(") && r.i+1 < len(r.tokens) && r.tokens[r.i+1].isKeyword("SUBPARTITION"):
+			r.next()
+			r.group() // Subpartitions are named by MySQL unless they're specified. SUBPARTITIONS is compared instead.
+		default:
+			values = append(values, r.next())
+		}
+	}
+
+	return &parser.PartitionDefinition{
+		Name:       parser.NewColIdent(name),
+		Definition: sql[tokens[0].pos:tokens[len(tokens)-1].end],
+		Values:     strings.Replace(normalizeTokens(values), "less than ( maxvalue )", "less than maxvalue", 1),
+	}, nil
+}
+
+// Split tokens by commas which are not in parentheses
+func splitByComma(tokens []token) [][]token {
+	var result [][]token
+	depth, start := 0, 0
+	for i, t := range tokens {
+		if t.is("(") {
+			depth++
+		} else if t.is(")") {
+			depth--
+		} else if t.is(",") && depth == 0 {
+			result = append(result, tokens[start:i])
+			start = i + 1
+		}
+	}
+	if start < len(tokens) {
+		result = append(result, tokens[start:])
+	}
+	return result
+}
+
+// Return the data type of RETURNS in the form of `SHOW CREATE FUNCTION`. CHARSET and COLLATE are ignored since it fills them.
+func (r *tokenReader) dataType() string {
+	name := strings.ToLower(r.next().text)
+	var args string
+	if r.acceptSymbol("(") {
+		args = normalizeTokens(r.group())
+	}
+
+	switch name {
+	case "integer":
+		name = "int"
+	case "bool", "boolean":
+		name, args = "tinyint", "1"
+	}
+	switch name {
+	case "tinyint", "smallint", "mediumint", "int", "bigint":
+		if name != "tinyint" || args != "1" {
+			args = "" // The display width is deprecated as of MySQL 8.0
+		}
+	}
+
+	dataType := name
+	if args != "" {
+		dataType += "(" + args + ")"
+	}
+	for {
+		switch {
+		case r.acceptKeywords("CHARACTER", "SET"), r.acceptKeywords("CHARSET"), r.acceptKeywords("COLLATE"):
+			r.next()
+		case r.peek().isKeyword("UNSIGNED", "ZEROFILL"):
+			dataType += " " + strings.ToLower(r.next().text)
+		default:
+			return dataType
+		}
+	}
+}
+
+// Join tokens with spaces. Keywords and identifiers are lowercased since they are case-insensitive.
+func normalizeTokens(tokens []token) string {
+	texts := make([]string, len(tokens))
+	for i, t := range tokens {
+		switch t.kind {
+		case wordToken:
+			texts[i] = strings.ToLower(t.text)
+		case identToken:
+			texts[i] = strings.ToLower(identValue(t))
+		default:
+			texts[i] = t.text
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+var whitespaces = regexp.MustCompile(`\s+`)
+
+// MySQL keeps the body of a stored program as it's written, so only whitespaces are normalized.
+func normalizeBody(body string) string {
+	return whitespaces.ReplaceAllString(strings.TrimSpace(body), " ")
+}
+
+func identValue(t token) string {
+	if t.kind != identToken {
+		return t.text
+	}
+	return strings.ReplaceAll(strings.TrimSuffix(strings.TrimPrefix(t.text, "`"), "`"), "``", "`")
+}
+
+// Unquote a string literal, whose quotes are escaped by a backslash or doubling them
+func stringValue(t token) string {
+	if t.kind != stringToken || len(t.text) < 2 {
+		return t.text
+	}
+	quote := t.text[0]
+	text := t.text[1 : len(t.text)-1]
+
+	var value strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' && i+1 < len(text) {
+			i++
+			switch text[i] {
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			case 'r':
+				value.WriteByte('\r')
+			case '0':
+				value.WriteByte(0)
+			default:
+				value.WriteByte(text[i])
+			}
+		} else if text[i] == quote && i+1 < len(text) && text[i+1] == quote {
+			i++
+			value.WriteByte(quote)
+		} else {
+			value.WriteByte(text[i])
+		}
+	}
+	return value.String()
+}
@@ -0,0 +1,121 @@
+package mysql
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParse(t *testing.T) {
+	tests, err := readTests("tests.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sqlParser := NewParser()
+	for name, sql := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err = sqlParser.Parse(sql)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func readTests(file string) (map[string]string, error) {
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var tests map[string]string
+	dec := yaml.NewDecoder(bytes.NewReader(buf))
+	dec.KnownFields(true)
+	err = dec.Decode(&tests)
+	if err != nil {
+		return nil, err
+	}
+
+	return tests, nil
+}
+
+func TestParseStatementBoundaries(t *testing.T) {
+	tests := map[string]struct {
+		sql  string
+		ddls []string
+	}{
+		"QuotedSemicolons": {
+			sql: "CREATE FUNCTION f() RETURNS text RETURN CONCAT('a;b', \"c;d\", 'it''s;', 'it\\'s;');\n" +
+				"CREATE TABLE `t;1` (id int);\n",
+			ddls: []string{
+				"CREATE FUNCTION f() RETURNS text RETURN CONCAT('a;b', \"c;d\", 'it''s;', 'it\\'s;')",
+				"CREATE TABLE `t;1` (id int)",
+			},
+		},
+		"Comments": {
+			sql: `CREATE PROCEDURE p()
+BEGIN
+  -- END;
+  # END;
+  /* END; */
+  SELECT 1;
+END;
+-- CREATE TABLE ignored (id int);
+CREATE TABLE t (id int);
+`,
+			ddls: []string{
+				"CREATE PROCEDURE p()\nBEGIN\n  -- END;\n  # END;\n  /* END; */\n  SELECT 1;\nEND",
+				"CREATE TABLE t (id int)",
+			},
+		},
+		"NestedBlocks": {
+			sql: `CREATE PROCEDURE p(n int)
+BEGIN
+  IF n > 0 THEN
+    BEGIN
+      SELECT 1;
+    END;
+  END IF;
+  CASE n WHEN 1 THEN SELECT 1; ELSE SELECT 2; END CASE;
+  l: LOOP
+    LEAVE l;
+  END LOOP;
+  WHILE n > 0 DO SET n = n - 1; END WHILE;
+  REPEAT SET n = n + 1; UNTIL n > 2 END REPEAT;
+END;
+CREATE EVENT e ON SCHEDULE EVERY 1 DAY DO BEGIN SELECT 1; SELECT 2; END;
+`,
+			ddls: []string{
+				"CREATE PROCEDURE p(n int)\nBEGIN\n  IF n > 0 THEN\n    BEGIN\n      SELECT 1;\n    END;\n  END IF;\n" +
+					"  CASE n WHEN 1 THEN SELECT 1; ELSE SELECT 2; END CASE;\n  l: LOOP\n    LEAVE l;\n  END LOOP;\n" +
+					"  WHILE n > 0 DO SET n = n - 1; END WHILE;\n  REPEAT SET n = n + 1; UNTIL n > 2 END REPEAT;\nEND",
+				"CREATE EVENT e ON SCHEDULE EVERY 1 DAY DO BEGIN SELECT 1; SELECT 2; END",
+			},
+		},
+		"VersionComment": {
+			sql: "CREATE TABLE t (id int) /*!50100 PARTITION BY HASH (id) PARTITIONS 2 */;\n",
+			ddls: []string{
+				"CREATE TABLE t (id int) /*!50100 PARTITION BY HASH (id) PARTITIONS 2 */",
+			},
+		},
+	}
+
+	sqlParser := NewParser()
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			stmts, err := sqlParser.Parse(test.sql)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ddls []string
+			for _, stmt := range stmts {
+				ddls = append(ddls, stmt.DDL)
+			}
+			assert.Equal(t, test.ddls, ddls)
+		})
+	}
+}
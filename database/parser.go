@@ -47,8 +47,14 @@ func (p GenericParser) Parse(sql string) ([]DDLStatement, error) {
 }
 
 func (p GenericParser) splitDDLs(str string) ([]string, error) {
+	// Comment lines are removed except `@renamed from` annotations, which are kept in the following DDL
 	re := regexp.MustCompilePOSIX("^--.*")
-	str = re.ReplaceAllString(str, "")
+	str = re.ReplaceAllStringFunc(str, func(comment string) string {
+		if strings.Contains(comment, "@renamed") {
+			return comment
+		}
+		return ""
+	})
 
 	ddls := strings.Split(str, ";")
 	var result []string
@@ -37,6 +37,12 @@ func NewDatabase(config database.Config) (database.Database, error) {
 func (d *PostgresDatabase) DumpDDLs() (string, error) {
 	var ddls []string
 
+	roleDDLs, err := d.roles()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, roleDDLs...)
+
 	schemaDDLs, err := d.schemas()
 	if err != nil {
 		return "", err
@@ -55,6 +61,24 @@ func (d *PostgresDatabase) DumpDDLs() (string, error) {
 	}
 	ddls = append(ddls, typeDDLs...)
 
+	domainDDLs, err := d.domains()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, domainDDLs...)
+
+	compositeTypeDDLs, err := d.compositeTypes()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, compositeTypeDDLs...)
+
+	sequenceDDLs, sequenceOwnerDDLs, err := d.sequences()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, sequenceDDLs...)
+
 	tableNames, err := d.tableNames()
 	if err != nil {
 		return "", err
@@ -83,6 +107,34 @@ func (d *PostgresDatabase) DumpDDLs() (string, error) {
 	}
 	ddls = append(ddls, matViewDDLs...)
 
+	// Bodies of SQL functions are validated against the tables and views on creation
+	functionDDLs, err := d.functions()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, functionDDLs...)
+
+	// Owners are set after the tables are created
+	ddls = append(ddls, sequenceOwnerDDLs...)
+
+	triggerDDLs, err := d.triggers()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, triggerDDLs...)
+
+	ownerDDLs, err := d.owners()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, ownerDDLs...)
+
+	privilegeDDLs, err := d.privileges()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, privilegeDDLs...)
+
 	return strings.Join(ddls, "\n\n"), nil
 }
 
@@ -93,9 +145,8 @@ func (d *PostgresDatabase) tableNames() ([]string, error) {
 		where n.nspname not in ('information_schema', 'pg_catalog')
 		and c.relkind in ('r', 'p')
 		and c.relpersistence in ('p', 'u')
-		and c.relispartition = false
 		and not exists (select * from pg_catalog.pg_depend d where c.oid = d.objid and d.deptype = 'e')
-		order by relname asc;
+		order by c.relispartition, relname asc;
 	`)
 	if err != nil {
 		return nil, err
@@ -264,7 +315,7 @@ func (d *PostgresDatabase) extensions() ([]string, error) {
 
 func (d *PostgresDatabase) types() ([]string, error) {
 	rows, err := d.db.Query(`
-		select n.nspname as type_schema, t.typname, string_agg(e.enumlabel, ' ')
+		select n.nspname as type_schema, t.typname, string_agg(quote_literal(e.enumlabel), ', ' order by e.enumsortorder)
 		from pg_enum e
 		join pg_type t on e.enumtypid = t.oid
 		inner join pg_catalog.pg_namespace n on t.typnamespace = n.oid
@@ -285,20 +336,422 @@ func (d *PostgresDatabase) types() ([]string, error) {
 		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, typeSchema) {
 			continue
 		}
-		enumLabels := []string{}
-		for _, label := range strings.Split(labels, " ") {
-			enumLabels = append(enumLabels, fmt.Sprintf("'%s'", label))
-		}
 		ddls = append(
 			ddls, fmt.Sprintf(
-				"CREATE TYPE %s.%s AS ENUM (%s);", escapeSQLName(typeSchema), escapeSQLName(typeName), strings.Join(enumLabels, ", "),
+				"CREATE TYPE %s.%s AS ENUM (%s);", escapeSQLName(typeSchema), escapeSQLName(typeName), labels,
 			),
 		)
 	}
 	return ddls, nil
 }
 
+func (d *PostgresDatabase) domains() ([]string, error) {
+	rows, err := d.db.Query(`
+		select n.nspname, t.typname, format_type(t.typbasetype, t.typtypmod), t.typdefault, t.typnotnull,
+		(
+			select string_agg(' CONSTRAINT ' || quote_ident(c.conname) || ' ' || pg_get_constraintdef(c.oid), '' order by c.conname)
+			from pg_catalog.pg_constraint c
+			where c.contypid = t.oid and c.contype = 'c'
+		)
+		from pg_catalog.pg_type t
+		inner join pg_catalog.pg_namespace n on t.typnamespace = n.oid
+		where t.typtype = 'd' and n.nspname not in ('information_schema', 'pg_catalog')
+		and not exists (select * from pg_depend d where d.objid = t.oid and d.deptype = 'e')
+		order by n.nspname, t.typname;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var schema, name, baseType string
+		var defaultValue, constraints sql.NullString
+		var notNull bool
+		if err := rows.Scan(&schema, &name, &baseType, &defaultValue, &notNull, &constraints); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, schema) {
+			continue
+		}
+		ddl := fmt.Sprintf("CREATE DOMAIN %s.%s AS %s", escapeSQLName(schema), escapeSQLName(name), baseType)
+		if defaultValue.Valid {
+			ddl += " DEFAULT " + defaultValue.String
+		}
+		if notNull {
+			ddl += " NOT NULL"
+		}
+		ddls = append(ddls, ddl+constraints.String+";")
+	}
+	return ddls, nil
+}
+
+func (d *PostgresDatabase) compositeTypes() ([]string, error) {
+	rows, err := d.db.Query(`
+		select n.nspname, t.typname,
+		coalesce(string_agg(quote_ident(a.attname) || ' ' || format_type(a.atttypid, a.atttypmod), ', ' order by a.attnum), '')
+		from pg_catalog.pg_type t
+		inner join pg_catalog.pg_namespace n on t.typnamespace = n.oid
+		inner join pg_catalog.pg_class c on c.oid = t.typrelid and c.relkind = 'c'
+		left join pg_catalog.pg_attribute a on a.attrelid = c.oid and a.attnum > 0 and not a.attisdropped
+		where t.typtype = 'c' and n.nspname not in ('information_schema', 'pg_catalog')
+		and not exists (select * from pg_depend d where d.objid = t.oid and d.deptype = 'e')
+		group by n.nspname, t.typname
+		order by n.nspname, t.typname;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var schema, name, attributes string
+		if err := rows.Scan(&schema, &name, &attributes); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, schema) {
+			continue
+		}
+		ddls = append(ddls, fmt.Sprintf("CREATE TYPE %s.%s AS (%s);", escapeSQLName(schema), escapeSQLName(name), attributes))
+	}
+	return ddls, nil
+}
+
+// Return CREATE SEQUENCE and ALTER SEQUENCE ... OWNED BY of sequences except the ones for identity and serial columns
+func (d *PostgresDatabase) sequences() ([]string, []string, error) {
+	rows, err := d.db.Query(`
+		select s.schemaname, s.sequencename, s.data_type::text, s.start_value, s.min_value, s.max_value, s.increment_by, s.cache_size, s.cycle,
+		(
+			select quote_ident(tn.nspname) || '.' || quote_ident(t.relname) || '.' || quote_ident(a.attname)
+			from pg_depend d
+			inner join pg_catalog.pg_class t on d.refobjid = t.oid
+			inner join pg_catalog.pg_namespace tn on t.relnamespace = tn.oid
+			inner join pg_catalog.pg_attribute a on a.attrelid = t.oid and a.attnum = d.refobjsubid
+			where d.classid = 'pg_class'::regclass and d.objid = c.oid and d.refclassid = 'pg_class'::regclass and d.deptype = 'a'
+		) as owned_by
+		from pg_sequences s
+		inner join pg_catalog.pg_namespace n on n.nspname = s.schemaname
+		inner join pg_catalog.pg_class c on c.relnamespace = n.oid and c.relname = s.sequencename
+		where s.schemaname not in ('information_schema', 'pg_catalog')
+		and not exists (select * from pg_depend d where d.objid = c.oid and d.deptype in ('e', 'i'))
+		and not exists (
+			-- Sequences of serial columns, which are owned by the column using them as its default
+			select * from pg_depend od
+			inner join pg_attrdef ad on ad.adrelid = od.refobjid and ad.adnum = od.refobjsubid
+			inner join pg_depend dd on dd.classid = 'pg_attrdef'::regclass and dd.objid = ad.oid and dd.refobjid = c.oid
+			where od.classid = 'pg_class'::regclass and od.objid = c.oid and od.deptype = 'a'
+		)
+		order by s.schemaname, s.sequencename;
+	`)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var ddls, ownerDDLs []string
+	for rows.Next() {
+		var schema, name, dataType string
+		var start, minValue, maxValue, increment, cache int64
+		var cycle bool
+		var ownedBy *string
+		if err := rows.Scan(&schema, &name, &dataType, &start, &minValue, &maxValue, &increment, &cache, &cycle, &ownedBy); err != nil {
+			return nil, nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, schema) {
+			continue
+		}
+		sequenceName := escapeSQLName(schema) + "." + escapeSQLName(name)
+		ddl := fmt.Sprintf(
+			"CREATE SEQUENCE %s AS %s START WITH %d INCREMENT BY %d MINVALUE %d MAXVALUE %d CACHE %d",
+			sequenceName, dataType, start, increment, minValue, maxValue, cache,
+		)
+		if cycle {
+			ddl += " CYCLE"
+		}
+		ddls = append(ddls, ddl+";")
+		if ownedBy != nil {
+			ownerDDLs = append(ownerDDLs, fmt.Sprintf("ALTER SEQUENCE %s OWNED BY %s;", sequenceName, *ownedBy))
+		}
+	}
+	return ddls, ownerDDLs, nil
+}
+
+func (d *PostgresDatabase) functions() ([]string, error) {
+	rows, err := d.db.Query(`
+		select n.nspname as function_schema, pg_get_functiondef(p.oid)
+		from pg_proc p
+		inner join pg_catalog.pg_namespace n on p.pronamespace = n.oid
+		where n.nspname not in ('information_schema', 'pg_catalog')
+		and p.prokind in ('f', 'p')
+		and not exists (select * from pg_depend d where d.objid = p.oid and d.deptype = 'e')
+		order by n.nspname, p.proname, p.oid;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var functionSchema, definition string
+		if err := rows.Scan(&functionSchema, &definition); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, functionSchema) {
+			continue
+		}
+		ddls = append(ddls, strings.TrimSpace(definition)+";")
+	}
+	return ddls, nil
+}
+
+// Dump managed roles and their memberships. Passwords are never dumped.
+func (d *PostgresDatabase) roles() ([]string, error) {
+	if len(d.config.ManagedRoles) == 0 {
+		return []string{}, nil
+	}
+
+	rows, err := d.db.Query(`
+		select rolname, rolsuper, rolcreatedb, rolcreaterole, rolinherit, rolcanlogin, rolreplication, rolbypassrls, rolconnlimit
+		from pg_catalog.pg_roles
+		order by rolname;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var name string
+		var superuser, createDB, createRole, inherit, login, replication, bypassRLS bool
+		var connectionLimit int
+		if err := rows.Scan(&name, &superuser, &createDB, &createRole, &inherit, &login, &replication, &bypassRLS, &connectionLimit); err != nil {
+			return nil, err
+		}
+		if !containsString(d.config.ManagedRoles, name) {
+			continue
+		}
+
+		// Options different from the defaults of CREATE ROLE
+		var options []string
+		for _, option := range []struct {
+			name    string
+			enabled bool
+		}{
+			{"SUPERUSER", superuser},
+			{"CREATEDB", createDB},
+			{"CREATEROLE", createRole},
+			{"NOINHERIT", !inherit},
+			{"LOGIN", login},
+			{"REPLICATION", replication},
+			{"BYPASSRLS", bypassRLS},
+		} {
+			if option.enabled {
+				options = append(options, option.name)
+			}
+		}
+		if connectionLimit != -1 {
+			options = append(options, fmt.Sprintf("CONNECTION LIMIT %d", connectionLimit))
+		}
+
+		ddl := "CREATE ROLE " + escapeSQLName(name)
+		if len(options) > 0 {
+			ddl += " WITH " + strings.Join(options, " ")
+		}
+		ddls = append(ddls, ddl+";")
+	}
+
+	rows, err = d.db.Query(`
+		select distinct r.rolname, m.rolname
+		from pg_catalog.pg_auth_members a
+		inner join pg_catalog.pg_roles r on a.roleid = r.oid
+		inner join pg_catalog.pg_roles m on a.member = m.oid
+		order by m.rolname, r.rolname;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var role, member string
+		if err := rows.Scan(&role, &member); err != nil {
+			return nil, err
+		}
+		if containsString(d.config.ManagedRoles, member) {
+			ddls = append(ddls, fmt.Sprintf("GRANT %s TO %s;", escapeSQLName(role), escapeSQLName(member)))
+		}
+	}
+	return ddls, nil
+}
+
+// Dump owners of tables which are managed roles
+func (d *PostgresDatabase) owners() ([]string, error) {
+	if len(d.config.ManagedRoles) == 0 {
+		return []string{}, nil
+	}
+
+	rows, err := d.db.Query(`
+		select n.nspname, c.relname, pg_catalog.pg_get_userbyid(c.relowner)
+		from pg_catalog.pg_class c
+		inner join pg_catalog.pg_namespace n on c.relnamespace = n.oid
+		where n.nspname not in ('information_schema', 'pg_catalog')
+		and c.relkind in ('r', 'p')
+		and c.relpersistence in ('p', 'u')
+		and not exists (select * from pg_catalog.pg_depend d where c.oid = d.objid and d.deptype = 'e')
+		order by n.nspname, c.relname;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var schema, table, owner string
+		if err := rows.Scan(&schema, &table, &owner); err != nil {
+			return nil, err
+		}
+		if !containsString(d.config.ManagedRoles, owner) {
+			continue
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, schema) {
+			continue
+		}
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s.%s OWNER TO %s;", escapeSQLName(schema), escapeSQLName(table), escapeSQLName(owner)))
+	}
+	return ddls, nil
+}
+
+// Dump GRANTs of the privileges of managed roles. Privileges of owners are implicit and not dumped.
+func (d *PostgresDatabase) privileges() ([]string, error) {
+	if len(d.config.ManagedRoles) == 0 {
+		return []string{}, nil
+	}
+
+	rows, err := d.db.Query(`
+		with objects as (
+			select case c.relkind when 'S' then 'SEQUENCE' else 'TABLE' end as object_type, n.nspname as object_schema, c.relname as object_name, '' as arguments,
+				c.relowner as owner, coalesce(c.relacl, acldefault(case c.relkind when 'S' then 's' else 'r' end, c.relowner)) as acl,
+				acldefault(case c.relkind when 'S' then 's' else 'r' end, c.relowner) as default_acl
+			from pg_catalog.pg_class c
+			inner join pg_catalog.pg_namespace n on c.relnamespace = n.oid
+			where c.relkind in ('r', 'p', 'v', 'm', 'f', 'S')
+			and not exists (select * from pg_catalog.pg_depend d where c.oid = d.objid and d.deptype = 'e')
+			union all
+			select 'SCHEMA', n.nspname, n.nspname, '', n.nspowner, coalesce(n.nspacl, acldefault('n', n.nspowner)), acldefault('n', n.nspowner)
+			from pg_catalog.pg_namespace n
+			where n.nspname not like 'pg\_%'
+			union all
+			select case p.prokind when 'p' then 'PROCEDURE' else 'FUNCTION' end, n.nspname, p.proname, pg_catalog.oidvectortypes(p.proargtypes),
+				p.proowner, coalesce(p.proacl, acldefault('f', p.proowner)), acldefault('f', p.proowner)
+			from pg_catalog.pg_proc p
+			inner join pg_catalog.pg_namespace n on p.pronamespace = n.oid
+			where p.prokind in ('f', 'p')
+			and not exists (select * from pg_catalog.pg_depend d where p.oid = d.objid and d.deptype = 'e')
+		)
+		select o.object_type, o.object_schema, o.object_name, o.arguments, coalesce(r.rolname, 'PUBLIC'),
+			array_to_string(array_agg(distinct a.privilege_type order by a.privilege_type), ','),
+			array_to_string(array_agg(distinct a.privilege_type order by a.privilege_type) filter (where a.is_grantable), ','),
+			array_to_string(array(select distinct d.privilege_type from aclexplode(o.default_acl) d order by 1), ',')
+		from objects o
+		cross join aclexplode(o.acl) a
+		left join pg_catalog.pg_roles r on a.grantee = r.oid
+		where o.object_schema not in ('information_schema', 'pg_catalog')
+		and a.grantee <> o.owner
+		group by o.object_type, o.object_schema, o.object_name, o.arguments, r.rolname, o.default_acl
+		order by o.object_type, o.object_schema, o.object_name, o.arguments, r.rolname nulls first;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var objectType, objectSchema, objectName, arguments, grantee, privileges, grantable, allPrivileges string
+		if err := rows.Scan(&objectType, &objectSchema, &objectName, &arguments, &grantee, &privileges, &grantable, &allPrivileges); err != nil {
+			return nil, err
+		}
+		if !containsString(d.config.ManagedRoles, grantee) {
+			continue
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, objectSchema) {
+			continue
+		}
+
+		object := objectType + " " + escapeSQLName(objectSchema)
+		switch objectType {
+		case "FUNCTION", "PROCEDURE":
+			object += "." + escapeSQLName(objectName) + "(" + arguments + ")"
+		case "TABLE", "SEQUENCE":
+			object += "." + escapeSQLName(objectName)
+		}
+		if grantee != "PUBLIC" {
+			grantee = escapeSQLName(grantee)
+		}
+
+		// Privileges are shown as ALL when all of them are granted
+		if privileges == allPrivileges {
+			privileges = "ALL"
+			if grantable == allPrivileges {
+				grantable = "ALL"
+			}
+		}
+		if privileges != grantable {
+			ddls = append(ddls, fmt.Sprintf("GRANT %s ON %s TO %s;", strings.ReplaceAll(privileges, ",", ", "), object, grantee))
+		}
+		if grantable != "" {
+			ddls = append(ddls, fmt.Sprintf("GRANT %s ON %s TO %s WITH GRANT OPTION;", strings.ReplaceAll(grantable, ",", ", "), object, grantee))
+		}
+	}
+	return ddls, nil
+}
+
+func (d *PostgresDatabase) triggers() ([]string, error) {
+	rows, err := d.db.Query(`
+		select n.nspname as table_schema, pg_get_triggerdef(t.oid)
+		from pg_trigger t
+		inner join pg_catalog.pg_class c on t.tgrelid = c.oid
+		inner join pg_catalog.pg_namespace n on c.relnamespace = n.oid
+		where n.nspname not in ('information_schema', 'pg_catalog')
+		and not t.tgisinternal
+		and c.relispartition = false
+		and not exists (select * from pg_depend d where d.objid = c.oid and d.deptype = 'e')
+		order by n.nspname, c.relname, t.tgname;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var tableSchema, definition string
+		if err := rows.Scan(&tableSchema, &definition); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, tableSchema) {
+			continue
+		}
+		ddls = append(ddls, definition+";")
+	}
+	return ddls, nil
+}
+
 func (d *PostgresDatabase) dumpTableDDL(table string) (string, error) {
+	partitionBy, partitionOf, err := d.getPartition(table)
+	if err != nil {
+		return "", err
+	}
+	if partitionOf != "" {
+		// Columns and constraints of a partition are inherited from its parent
+		return d.dumpPartitionDDL(table, partitionBy, partitionOf)
+	}
+
 	cols, err := d.getColumns(table)
 	if err != nil {
 		return "", err
@@ -343,7 +796,73 @@ func (d *PostgresDatabase) dumpTableDDL(table string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return buildDumpTableDDL(table, cols, pkeyName, pkeyCols, indexDefs, foreignDefs, exclusionDefs, policyDefs, comments, checkConstraints, uniqueConstraints, d.GetDefaultSchema()), nil
+	rowSecurityDefs, err := d.getRowSecurityDefs(table)
+	if err != nil {
+		return "", err
+	}
+	ddl := buildDumpTableDDL(table, cols, pkeyName, pkeyCols, indexDefs, foreignDefs, exclusionDefs, policyDefs, comments, checkConstraints, uniqueConstraints, d.GetDefaultSchema())
+	if partitionBy != "" {
+		ddl = strings.Replace(ddl, "\n);", "\n) PARTITION BY "+partitionBy+";", 1)
+	}
+	for _, v := range rowSecurityDefs {
+		ddl += "\n" + v
+	}
+	return ddl, nil
+}
+
+func (d *PostgresDatabase) dumpPartitionDDL(table string, partitionBy string, partitionOf string) (string, error) {
+	indexDefs, err := d.getIndexDefs(table)
+	if err != nil {
+		return "", err
+	}
+	comments, err := d.getComments(table)
+	if err != nil {
+		return "", err
+	}
+	rowSecurityDefs, err := d.getRowSecurityDefs(table)
+	if err != nil {
+		return "", err
+	}
+
+	var queryBuilder strings.Builder
+	schema, name := splitTableName(table, d.GetDefaultSchema())
+	fmt.Fprintf(&queryBuilder, "CREATE TABLE %s.%s PARTITION OF %s", escapeSQLName(schema), escapeSQLName(name), partitionOf)
+	if partitionBy != "" {
+		fmt.Fprintf(&queryBuilder, " PARTITION BY %s", partitionBy)
+	}
+	fmt.Fprint(&queryBuilder, ";\n")
+	for _, v := range indexDefs {
+		fmt.Fprintf(&queryBuilder, "%s;\n", v)
+	}
+	for _, v := range comments {
+		fmt.Fprintf(&queryBuilder, "%s\n", v)
+	}
+	for _, v := range rowSecurityDefs {
+		fmt.Fprintf(&queryBuilder, "%s\n", v)
+	}
+	return strings.TrimSuffix(queryBuilder.String(), "\n"), nil
+}
+
+// Return the partition key of a partitioned table, and the parent and bound of a partition like `"public"."p" FOR VALUES IN (1)`
+func (d *PostgresDatabase) getPartition(table string) (string, string, error) {
+	const query = `SELECT coalesce(pg_get_partkeydef(c.oid), ''), coalesce(pn.nspname, ''), coalesce(p.relname, ''), coalesce(pg_get_expr(c.relpartbound, c.oid), '')
+	FROM   pg_class c
+	JOIN   pg_namespace n ON n.oid = c.relnamespace
+	LEFT JOIN pg_inherits i ON i.inhrelid = c.oid AND c.relispartition
+	LEFT JOIN pg_class p ON p.oid = i.inhparent
+	LEFT JOIN pg_namespace pn ON pn.oid = p.relnamespace
+	WHERE  n.nspname = $1
+	AND    c.relname = $2;`
+
+	schema, name := splitTableName(table, d.GetDefaultSchema())
+	var partitionBy, parentSchema, parentName, bound string
+	if err := d.db.QueryRow(query, schema, name).Scan(&partitionBy, &parentSchema, &parentName, &bound); err != nil {
+		return "", "", err
+	}
+	if parentName == "" {
+		return partitionBy, "", nil
+	}
+	return partitionBy, fmt.Sprintf("%s.%s %s", escapeSQLName(parentSchema), escapeSQLName(parentName), bound), nil
 }
 
 func buildDumpTableDDL(table string, columns []column, pkeyName string, pkeyCols, indexDefs, foreignDefs, exclusionDefs, policyDefs, comments []string, checkConstraints, uniqueConstraints map[string]string, defaultSchema string) string {
@@ -457,7 +976,14 @@ func (d *PostgresDatabase) getColumns(table string) ([]column, error) {
 	      ELSE s.data_type
 	      END,
 	      format_type(f.atttypid, f.atttypmod),
-	      s.identity_generation
+	      s.identity_generation,
+	      EXISTS (
+	        -- The default is nextval() of a sequence owned by the column, i.e. it's serial
+	        SELECT * FROM pg_depend dd
+	        JOIN pg_depend sd ON sd.objid = dd.refobjid AND sd.classid = 'pg_class'::regclass
+	        WHERE dd.classid = 'pg_attrdef'::regclass AND dd.objid = d.oid AND dd.refclassid = 'pg_class'::regclass
+	        AND sd.refobjid = c.oid AND sd.refobjsubid = f.attnum AND sd.deptype = 'a'
+	      )
 	    FROM pg_attribute f
 	    JOIN pg_class c ON c.oid = f.attrelid JOIN pg_type t ON t.oid = f.atttypid
 	    LEFT JOIN pg_attrdef d ON d.adrelid = c.oid AND d.adnum = f.attnum
@@ -507,7 +1033,8 @@ func (d *PostgresDatabase) getColumns(table string) ([]column, error) {
 		col := column{}
 		var colName, isNullable, dataType, formattedDataType string
 		var colDefault, idGen, checkName, checkDefinition *string
-		err = rows.Scan(&colName, &colDefault, &isNullable, &dataType, &formattedDataType, &idGen, &checkName, &checkDefinition)
+		var isSerial bool
+		err = rows.Scan(&colName, &colDefault, &isNullable, &dataType, &formattedDataType, &idGen, &isSerial, &checkName, &checkDefinition)
 		if err != nil {
 			return nil, err
 		}
@@ -515,9 +1042,7 @@ func (d *PostgresDatabase) getColumns(table string) ([]column, error) {
 		if colDefault != nil {
 			col.Default = *colDefault
 		}
-		if colDefault != nil && strings.HasPrefix(*colDefault, "nextval(") {
-			col.IsAutoIncrement = true
-		}
+		col.IsAutoIncrement = isSerial
 		col.Nullable = isNullable == "YES"
 		col.dataType = dataType
 		col.formattedDataType = formattedDataType
@@ -552,6 +1077,13 @@ func (d *PostgresDatabase) getIndexDefs(table string) ([]string, error) {
 	WHERE  schemaname = $1
 	AND    tablename = $2
 	AND    indexName NOT IN (SELECT name FROM exclude_constraints)
+	AND    NOT EXISTS (
+	  -- Indexes of a partition created by an index of its parent
+	  SELECT * FROM pg_inherits i
+	  JOIN   pg_class ic ON ic.oid = i.inhrelid
+	  JOIN   pg_namespace icn ON icn.oid = ic.relnamespace
+	  WHERE  icn.nspname = $1 AND ic.relname = indexName
+	)
 	`
 	schema, table := splitTableName(table, d.GetDefaultSchema())
 	rows, err := d.db.Query(query, schema, table)
@@ -569,7 +1101,8 @@ func (d *PostgresDatabase) getIndexDefs(table string) ([]string, error) {
 		}
 		indexName = strings.Trim(indexName, `" `)
 
-		indexes = append(indexes, indexdef)
+		// An index of a partitioned table is shown with ONLY when it's not attached to all partitions yet
+		indexes = append(indexes, strings.Replace(indexdef, " ON ONLY ", " ON ", 1))
 	}
 	return indexes, nil
 }
@@ -895,6 +1428,30 @@ func (d *PostgresDatabase) getPolicyDefs(table string) ([]string, error) {
 	return defs, nil
 }
 
+// Return ALTER TABLE statements to enable and force row level security of the table
+func (d *PostgresDatabase) getRowSecurityDefs(table string) ([]string, error) {
+	const query = `SELECT c.relrowsecurity, c.relforcerowsecurity
+	FROM   pg_class c
+	JOIN   pg_namespace n ON n.oid = c.relnamespace
+	WHERE  n.nspname = $1
+	AND    c.relname = $2;`
+
+	schema, name := splitTableName(table, d.GetDefaultSchema())
+	var enabled, forced bool
+	if err := d.db.QueryRow(query, schema, name).Scan(&enabled, &forced); err != nil {
+		return nil, err
+	}
+
+	defs := []string{}
+	if enabled {
+		defs = append(defs, fmt.Sprintf("ALTER TABLE %s.%s ENABLE ROW LEVEL SECURITY;", escapeSQLName(schema), escapeSQLName(name)))
+	}
+	if forced {
+		defs = append(defs, fmt.Sprintf("ALTER TABLE %s.%s FORCE ROW LEVEL SECURITY;", escapeSQLName(schema), escapeSQLName(name)))
+	}
+	return defs, nil
+}
+
 func (d *PostgresDatabase) getComments(table string) ([]string, error) {
 	schema, table := splitTableName(table, d.GetDefaultSchema())
 	var ddls []string
@@ -989,23 +1546,28 @@ func postgresBuildDSN(config database.Config) string {
 		host = config.Socket
 	}
 
+	// Parameters given by the connection URL take precedence over the environment variables
 	var options []string
-	if sslmode, ok := os.LookupEnv("PGSSLMODE"); ok { // TODO: have this in database.Config, or standardize config with DSN?
+	if sslmode, ok := os.LookupEnv("PGSSLMODE"); ok && !config.Params.Has("sslmode") { // TODO: have this in database.Config, or standardize config with DSN?
 		options = append(options, fmt.Sprintf("sslmode=%s", sslmode)) // TODO: uri escape
 	}
 
-	if sslrootcert, ok := os.LookupEnv("PGSSLROOTCERT"); ok { // TODO: have this in database.Config, or standardize config with DSN?
+	if sslrootcert, ok := os.LookupEnv("PGSSLROOTCERT"); ok && !config.Params.Has("sslrootcert") { // TODO: have this in database.Config, or standardize config with DSN?
 		options = append(options, fmt.Sprintf("sslrootcert=%s", sslrootcert))
 	}
 
-	if sslcert, ok := os.LookupEnv("PGSSLCERT"); ok { // TODO: have this in database.Config, or standardize config with DSN?
+	if sslcert, ok := os.LookupEnv("PGSSLCERT"); ok && !config.Params.Has("sslcert") { // TODO: have this in database.Config, or standardize config with DSN?
 		options = append(options, fmt.Sprintf("sslcert=%s", sslcert))
 	}
 
-	if sslkey, ok := os.LookupEnv("PGSSLKEY"); ok { // TODO: have this in database.Config, or standardize config with DSN?
+	if sslkey, ok := os.LookupEnv("PGSSLKEY"); ok && !config.Params.Has("sslkey") { // TODO: have this in database.Config, or standardize config with DSN?
 		options = append(options, fmt.Sprintf("sslkey=%s", sslkey))
 	}
 
+	if len(config.Params) > 0 {
+		options = append(options, config.Params.Encode())
+	}
+
 	// `QueryEscape` instead of `PathEscape` so that colon can be escaped.
 	return fmt.Sprintf("postgres://%s:%s@%s/%s?%s", url.QueryEscape(user), url.QueryEscape(password), host, database, strings.Join(options, "&"))
 }
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/sqldef/sqldef/v2/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostgresBuildDSN(t *testing.T) {
+	t.Setenv("PGSSLMODE", "require")
+	t.Setenv("PGSSLROOTCERT", "/etc/ssl/root.crt")
+
+	config := database.Config{User: "postgres", Host: "localhost", Port: 5432, DbName: "psqldef_test"}
+	assert.Equal(t, "postgres://postgres:@localhost:5432/psqldef_test?sslmode=require&sslrootcert=/etc/ssl/root.crt", postgresBuildDSN(config))
+
+	// Parameters of the connection URL take precedence over the environment variables
+	config.Params = url.Values{"sslmode": {"disable"}}
+	assert.Equal(t, "postgres://postgres:@localhost:5432/psqldef_test?sslrootcert=/etc/ssl/root.crt&sslmode=disable", postgresBuildDSN(config))
+}
@@ -3,6 +3,8 @@ package postgres
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	pgquery "github.com/pganalyze/pg_query_go/v6"
@@ -49,7 +51,7 @@ func (p PostgresParser) Parse(sql string) ([]database.DDLStatement, error) {
 			// Otherwise, fallback to the generic parser. We intend to deprecate this path in the future.
 			var stmts []database.DDLStatement
 			if !p.testing { // Disable fallback in parser tests
-				stmts, err = p.parser.Parse(ddl)
+				stmts, err = p.parseWithGenericParser(rawStmt.Stmt, ddl)
 			}
 			if err != nil {
 				return nil, err
@@ -68,6 +70,28 @@ func (p PostgresParser) Parse(sql string) ([]database.DDLStatement, error) {
 	return statements, nil
 }
 
+var withDataSuffix = regexp.MustCompile(`(?i)\s+WITH\s+(NO\s+)?DATA\s*;?$`)
+
+// Parse the statement with the generic parser. It doesn't support `WITH [NO] DATA` of materialized views, so it's handled here.
+func (p PostgresParser) parseWithGenericParser(node *pgquery.Node, ddl string) ([]database.DDLStatement, error) {
+	createTableAs, ok := node.Node.(*pgquery.Node_CreateTableAsStmt)
+	if !ok || createTableAs.CreateTableAsStmt.Objtype != pgquery.ObjectType_OBJECT_MATVIEW {
+		return p.parser.Parse(ddl)
+	}
+
+	stmts, err := p.parser.Parse(withDataSuffix.ReplaceAllString(ddl, ""))
+	if err != nil {
+		return nil, err
+	}
+	for i, stmt := range stmts {
+		if view, ok := stmt.Statement.(*parser.DDL); ok && view.View != nil {
+			view.View.WithNoData = createTableAs.CreateTableAsStmt.Into.SkipData
+		}
+		stmts[i].DDL = ddl
+	}
+	return stmts, nil
+}
+
 func (p PostgresParser) parseStmt(node *pgquery.Node) (parser.Statement, error) {
 	switch stmt := node.Node.(type) {
 	case *pgquery.Node_CreateStmt:
@@ -76,6 +100,8 @@ func (p PostgresParser) parseStmt(node *pgquery.Node) (parser.Statement, error)
 		return p.parseIndexStmt(stmt.IndexStmt)
 	case *pgquery.Node_ViewStmt:
 		return p.parseViewStmt(stmt.ViewStmt)
+	case *pgquery.Node_CreateTableAsStmt:
+		return p.parseCreateTableAsStmt(stmt.CreateTableAsStmt)
 	case *pgquery.Node_CommentStmt:
 		return p.parseCommentStmt(stmt.CommentStmt)
 	case *pgquery.Node_CreateExtensionStmt:
@@ -84,6 +110,26 @@ func (p PostgresParser) parseStmt(node *pgquery.Node) (parser.Statement, error)
 		return p.parseAlterTableStmt(stmt.AlterTableStmt)
 	case *pgquery.Node_CreateSchemaStmt:
 		return p.parseCreateSchemaStmt(stmt.CreateSchemaStmt)
+	case *pgquery.Node_CreateFunctionStmt:
+		return p.parseCreateFunctionStmt(stmt.CreateFunctionStmt)
+	case *pgquery.Node_CreateTrigStmt:
+		return p.parseCreateTrigStmt(stmt.CreateTrigStmt)
+	case *pgquery.Node_CreateSeqStmt:
+		return p.parseCreateSeqStmt(stmt.CreateSeqStmt)
+	case *pgquery.Node_AlterSeqStmt:
+		return p.parseAlterSeqStmt(stmt.AlterSeqStmt)
+	case *pgquery.Node_CreateEnumStmt:
+		return p.parseCreateEnumStmt(stmt.CreateEnumStmt)
+	case *pgquery.Node_CompositeTypeStmt:
+		return p.parseCompositeTypeStmt(stmt.CompositeTypeStmt)
+	case *pgquery.Node_CreateDomainStmt:
+		return p.parseCreateDomainStmt(stmt.CreateDomainStmt)
+	case *pgquery.Node_GrantStmt:
+		return p.parseGrantStmt(stmt.GrantStmt)
+	case *pgquery.Node_CreateRoleStmt:
+		return p.parseCreateRoleStmt(stmt.CreateRoleStmt)
+	case *pgquery.Node_GrantRoleStmt:
+		return p.parseGrantRoleStmt(stmt.GrantRoleStmt)
 	default:
 		return nil, fmt.Errorf("unknown node in parseStmt: %#v", stmt)
 	}
@@ -99,6 +145,11 @@ func (p PostgresParser) parseCreateStmt(stmt *pgquery.CreateStmt) (parser.Statem
 		return nil, err
 	}
 
+	partitionBy, partitionOf, err := p.parsePartition(stmt)
+	if err != nil {
+		return nil, err
+	}
+
 	var columns []*parser.ColumnDefinition
 	var indexes []*parser.IndexDefinition
 	var foreignKeys []*parser.ForeignKeyDefinition
@@ -193,10 +244,57 @@ func (p PostgresParser) parseCreateStmt(stmt *pgquery.CreateStmt) (parser.Statem
 			Checks:      checks,
 			Exclusions:  exclusions,
 			Options:     map[string]string{},
+			PartitionBy: partitionBy,
+			PartitionOf: partitionOf,
 		},
 	}, nil
 }
 
+// Return the partition key and the parent of a table. They are normalized by deparsing them with placeholder names.
+func (p PostgresParser) parsePartition(stmt *pgquery.CreateStmt) (string, *parser.PartitionOf, error) {
+	var partitionBy string
+	if stmt.Partspec != nil {
+		ddl, err := deparseCreateStmt(&pgquery.CreateStmt{
+			Relation: &pgquery.RangeVar{Relname: "p", Inh: true, Relpersistence: "p"},
+			Partspec: stmt.Partspec,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		_, partitionBy, _ = strings.Cut(ddl, " PARTITION BY ")
+	}
+
+	if stmt.Partbound == nil {
+		return partitionBy, nil, nil
+	}
+	if len(stmt.InhRelations) != 1 || stmt.InhRelations[0].GetRangeVar() == nil {
+		return "", nil, fmt.Errorf("unhandled parent in parsePartition: %#v", stmt.InhRelations)
+	}
+	parent, err := p.parseTableName(stmt.InhRelations[0].GetRangeVar())
+	if err != nil {
+		return "", nil, err
+	}
+	placeholder := &pgquery.RangeVar{Relname: "p", Inh: true, Relpersistence: "p"}
+	ddl, err := deparseCreateStmt(&pgquery.CreateStmt{
+		Relation:     placeholder,
+		InhRelations: []*pgquery.Node{{Node: &pgquery.Node_RangeVar{RangeVar: placeholder}}},
+		Partbound:    stmt.Partbound,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return partitionBy, &parser.PartitionOf{
+		Parent: parent,
+		Bound:  strings.TrimPrefix(ddl, "CREATE TABLE p PARTITION OF p "),
+	}, nil
+}
+
+func deparseCreateStmt(stmt *pgquery.CreateStmt) (string, error) {
+	return go_pgquery.Deparse(&pgquery.ParseResult{
+		Stmts: []*pgquery.RawStmt{{Stmt: &pgquery.Node{Node: &pgquery.Node_CreateStmt{CreateStmt: stmt}}}},
+	})
+}
+
 func (p PostgresParser) parseIndexStmt(stmt *pgquery.IndexStmt) (parser.Statement, error) {
 	table, err := p.parseTableName(stmt.Relation)
 	if err != nil {
@@ -229,10 +327,11 @@ func (p PostgresParser) parseIndexStmt(stmt *pgquery.IndexStmt) (parser.Statemen
 		Table:   table,
 		NewName: table,
 		IndexSpec: &parser.IndexSpec{
-			Name:   parser.NewColIdent(stmt.Idxname),
-			Type:   parser.NewColIdent(stmt.AccessMethod),
-			Unique: stmt.Unique,
-			Where:  where,
+			Name:         parser.NewColIdent(stmt.Idxname),
+			Type:         parser.NewColIdent(stmt.AccessMethod),
+			Unique:       stmt.Unique,
+			Concurrently: stmt.Concurrent,
+			Where:        where,
 		},
 		IndexCols: indexCols,
 	}, nil
@@ -265,6 +364,48 @@ func (p PostgresParser) parseViewStmt(stmt *pgquery.ViewStmt) (parser.Statement,
 	}, nil
 }
 
+func (p PostgresParser) parseCreateTableAsStmt(stmt *pgquery.CreateTableAsStmt) (parser.Statement, error) {
+	if stmt.Objtype != pgquery.ObjectType_OBJECT_MATVIEW {
+		return nil, fmt.Errorf("unhandled object type in parseCreateTableAsStmt: %s", stmt.Objtype)
+	}
+	viewName, err := p.parseTableName(stmt.Into.Rel)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition parser.SelectStatement
+	switch node := stmt.Query.Node.(type) {
+	case *pgquery.Node_SelectStmt:
+		definition, err = p.parseSelectStmt(node.SelectStmt)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown node in parseCreateTableAsStmt: %#v", node)
+	}
+
+	// `SELECT *` is expanded to columns in the dumped definition, so it's not comparable.
+	normalized := true
+	if selectStmt, ok := definition.(*parser.Select); ok {
+		for _, expr := range selectStmt.SelectExprs {
+			if _, ok := expr.(*parser.StarExpr); ok {
+				normalized = false
+			}
+		}
+	}
+
+	return &parser.DDL{
+		Action: parser.CreateView,
+		View: &parser.View{
+			Type:       parser.MaterializedViewStr,
+			Name:       viewName,
+			Definition: definition,
+			WithNoData: stmt.Into.SkipData,
+			Normalized: normalized,
+		},
+	}, nil
+}
+
 func (p PostgresParser) parseSelectStmt(stmt *pgquery.SelectStmt) (parser.SelectStatement, error) {
 	unhandled := stmt.IntoClause != nil ||
 		stmt.WindowClause != nil ||
@@ -825,6 +966,13 @@ func (p PostgresParser) parseExtensionStmt(stmt *pgquery.CreateExtensionStmt) (p
 	}, nil
 }
 
+var rowSecurityOptions = map[pgquery.AlterTableType]string{
+	pgquery.AlterTableType_AT_EnableRowSecurity:  "ENABLE",
+	pgquery.AlterTableType_AT_DisableRowSecurity: "DISABLE",
+	pgquery.AlterTableType_AT_ForceRowSecurity:   "FORCE",
+	pgquery.AlterTableType_AT_NoForceRowSecurity: "NO FORCE",
+}
+
 func (p PostgresParser) parseAlterTableStmt(stmt *pgquery.AlterTableStmt) (parser.Statement, error) {
 	tableName, err := p.parseTableName(stmt.Relation)
 	if err != nil {
@@ -835,7 +983,28 @@ func (p PostgresParser) parseAlterTableStmt(stmt *pgquery.AlterTableStmt) (parse
 		return nil, fmt.Errorf("multiple actions are not supported in parseAlterTableStmt")
 	}
 
-	switch node := stmt.Cmds[0].Node.(*pgquery.Node_AlterTableCmd).AlterTableCmd.Def.Node.(type) {
+	cmd := stmt.Cmds[0].Node.(*pgquery.Node_AlterTableCmd).AlterTableCmd
+	if cmd.Subtype == pgquery.AlterTableType_AT_ChangeOwner {
+		owner, err := parseRoleName(cmd.Newowner)
+		if err != nil {
+			return nil, err
+		}
+		return &parser.DDL{
+			Action: parser.AlterOwner,
+			Table:  tableName,
+			Owner:  owner,
+		}, nil
+	}
+
+	if rowSecurity, ok := rowSecurityOptions[cmd.Subtype]; ok {
+		return &parser.DDL{
+			Action:      parser.AlterRowSecurity,
+			Table:       tableName,
+			RowSecurity: rowSecurity,
+		}, nil
+	}
+
+	switch node := cmd.Def.Node.(type) {
 	case *pgquery.Node_Constraint:
 		return p.parseConstraint(node.Constraint, tableName)
 	default:
@@ -1312,6 +1481,626 @@ func (p PostgresParser) parseCreateSchemaStmt(stmt *pgquery.CreateSchemaStmt) (p
 	}, nil
 }
 
+func (p PostgresParser) parseCreateFunctionStmt(stmt *pgquery.CreateFunctionStmt) (parser.Statement, error) {
+	var name parser.TableName
+	switch len(stmt.Funcname) {
+	case 1:
+		name.Name = parser.NewTableIdent(stmt.Funcname[0].GetString_().Sval)
+	case 2:
+		name.Schema = parser.NewTableIdent(stmt.Funcname[0].GetString_().Sval)
+		name.Name = parser.NewTableIdent(stmt.Funcname[1].GetString_().Sval)
+	default:
+		return nil, fmt.Errorf("unhandled function name in parseCreateFunctionStmt: %#v", stmt.Funcname)
+	}
+
+	var arguments, outputs []string
+	for _, node := range stmt.Parameters {
+		param := node.GetFunctionParameter()
+		if param == nil {
+			return nil, fmt.Errorf("unhandled parameter in parseCreateFunctionStmt: %#v", node)
+		}
+		argType := functionTypeName(param.ArgType)
+		switch param.Mode {
+		case pgquery.FunctionParameterMode_FUNC_PARAM_OUT, pgquery.FunctionParameterMode_FUNC_PARAM_TABLE:
+			if stmt.IsProcedure { // OUT arguments of procedures are a part of their signature
+				arguments = append(arguments, argType)
+			} else {
+				outputs = append(outputs, argType)
+			}
+		case pgquery.FunctionParameterMode_FUNC_PARAM_INOUT:
+			arguments = append(arguments, argType)
+			outputs = append(outputs, argType)
+		case pgquery.FunctionParameterMode_FUNC_PARAM_VARIADIC:
+			arguments = append(arguments, "VARIADIC "+argType)
+		default:
+			arguments = append(arguments, argType)
+		}
+	}
+
+	var returns string
+	if stmt.ReturnType != nil {
+		returns = functionTypeName(stmt.ReturnType)
+		if stmt.ReturnType.Setof {
+			returns = "SETOF " + returns
+		}
+	}
+	if len(outputs) > 0 {
+		returns += "(" + strings.Join(outputs, ", ") + ")"
+	}
+
+	definition, err := normalizeCreateFunctionStmt(stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parser.DDL{
+		Action: parser.CreateFunction,
+		Function: &parser.Function{
+			Name:       name,
+			Procedure:  stmt.IsProcedure,
+			Arguments:  arguments,
+			Returns:    returns,
+			Definition: definition,
+		},
+	}, nil
+}
+
+// Options of CREATE FUNCTION that pg_get_functiondef omits when they're default
+var defaultFunctionOptions = map[string]string{
+	"volatility": "volatile",
+	"strict":     "false",
+	"security":   "false",
+	"leakproof":  "false",
+	"parallel":   "unsafe",
+}
+
+// Deparse CREATE FUNCTION in a form that doesn't change between the desired schema and pg_get_functiondef:
+// without the schema and default options, and with sorted options, unspecified IN modes and uncasted defaults.
+// Note that this modifies the given stmt.
+func normalizeCreateFunctionStmt(stmt *pgquery.CreateFunctionStmt) (string, error) {
+	stmt.Replace = true
+	stmt.Funcname = stmt.Funcname[len(stmt.Funcname)-1:]
+
+	for _, node := range stmt.Parameters {
+		param := node.GetFunctionParameter()
+		if param.Mode == pgquery.FunctionParameterMode_FUNC_PARAM_IN {
+			param.Mode = pgquery.FunctionParameterMode_FUNC_PARAM_DEFAULT
+		}
+		if typeCast := param.Defexpr.GetTypeCast(); typeCast != nil && typeCast.Arg.GetAConst() != nil {
+			param.Defexpr = typeCast.Arg
+		}
+	}
+
+	var options []*pgquery.Node
+	for _, node := range stmt.Options {
+		option := node.GetDefElem()
+		if option != nil {
+			var value string
+			switch arg := option.Arg.GetNode().(type) {
+			case *pgquery.Node_String_:
+				value = strings.ToLower(arg.String_.Sval)
+			case *pgquery.Node_Boolean:
+				value = fmt.Sprint(arg.Boolean.Boolval)
+			}
+			if defaultValue, ok := defaultFunctionOptions[option.Defname]; ok && value == defaultValue {
+				continue
+			}
+		}
+		options = append(options, node)
+	}
+	slices.SortStableFunc(options, func(a, b *pgquery.Node) int {
+		return strings.Compare(a.GetDefElem().GetDefname(), b.GetDefElem().GetDefname())
+	})
+	stmt.Options = options
+
+	return go_pgquery.Deparse(&pgquery.ParseResult{
+		Stmts: []*pgquery.RawStmt{{Stmt: &pgquery.Node{Node: &pgquery.Node_CreateFunctionStmt{CreateFunctionStmt: stmt}}}},
+	})
+}
+
+// Bits of CreateTrigStmt.Timing and CreateTrigStmt.Events defined in PostgreSQL's catalog/pg_trigger.h
+const (
+	triggerTypeBefore   = 1 << 1
+	triggerTypeInsert   = 1 << 2
+	triggerTypeDelete   = 1 << 3
+	triggerTypeUpdate   = 1 << 4
+	triggerTypeTruncate = 1 << 5
+	triggerTypeInstead  = 1 << 6
+)
+
+func (p PostgresParser) parseCreateTrigStmt(stmt *pgquery.CreateTrigStmt) (parser.Statement, error) {
+	tableName, err := p.parseTableName(stmt.Relation)
+	if err != nil {
+		return nil, err
+	}
+
+	var time string
+	switch {
+	case stmt.Timing&triggerTypeBefore != 0:
+		time = "BEFORE"
+	case stmt.Timing&triggerTypeInstead != 0:
+		time = "INSTEAD OF"
+	default:
+		time = "AFTER"
+	}
+
+	var events []string
+	if stmt.Events&triggerTypeInsert != 0 {
+		events = append(events, "INSERT")
+	}
+	if stmt.Events&triggerTypeUpdate != 0 {
+		events = append(events, "UPDATE")
+	}
+	if stmt.Events&triggerTypeDelete != 0 {
+		events = append(events, "DELETE")
+	}
+	if stmt.Events&triggerTypeTruncate != 0 {
+		events = append(events, "TRUNCATE")
+	}
+
+	// Deparse it in a form that doesn't change between the desired schema and pg_get_triggerdef. The table is compared
+	// separately, and the function is compared by its name since pg_get_triggerdef omits the schema in search_path.
+	stmt.Replace = false
+	stmt.Relation = &pgquery.RangeVar{Relname: stmt.Relation.Relname, Inh: true, Relpersistence: stmt.Relation.Relpersistence}
+	stmt.Funcname = stmt.Funcname[len(stmt.Funcname)-1:]
+	if stmt.Constrrel != nil {
+		stmt.Constrrel = &pgquery.RangeVar{Relname: stmt.Constrrel.Relname, Inh: true, Relpersistence: stmt.Constrrel.Relpersistence}
+	}
+	definition, err := go_pgquery.Deparse(&pgquery.ParseResult{
+		Stmts: []*pgquery.RawStmt{{Stmt: &pgquery.Node{Node: &pgquery.Node_CreateTrigStmt{CreateTrigStmt: stmt}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &parser.DDL{
+		Action: parser.CreateTrigger,
+		Trigger: &parser.Trigger{
+			Name:       parser.NewColIdent(stmt.Trigname),
+			TableName:  tableName,
+			Time:       time,
+			Event:      events,
+			Constraint: stmt.Isconstraint,
+			Definition: definition,
+		},
+	}, nil
+}
+
+// Names of sequence types shown by format_type()
+var sequenceTypes = map[string]string{
+	"int2": "smallint",
+	"int4": "integer",
+	"int8": "bigint",
+}
+
+func (p PostgresParser) parseCreateSeqStmt(stmt *pgquery.CreateSeqStmt) (parser.Statement, error) {
+	tableName, err := p.parseTableName(stmt.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence := &parser.Sequence{
+		Name:        tableName.Name.String(),
+		IfNotExists: stmt.IfNotExists,
+	}
+	if err := parseSequenceOptions(stmt.Options, sequence); err != nil {
+		return nil, err
+	}
+
+	return &parser.DDL{
+		Action:   parser.CreateSequence,
+		Table:    tableName,
+		Sequence: sequence,
+	}, nil
+}
+
+// Only `ALTER SEQUENCE ... OWNED BY` is supported, which is needed to own a sequence by a table created after it.
+func (p PostgresParser) parseAlterSeqStmt(stmt *pgquery.AlterSeqStmt) (parser.Statement, error) {
+	tableName, err := p.parseTableName(stmt.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmt.Options) != 1 || stmt.Options[0].GetDefElem().GetDefname() != "owned_by" {
+		return nil, fmt.Errorf("unhandled options in parseAlterSeqStmt: %#v", stmt.Options)
+	}
+
+	sequence := &parser.Sequence{Name: tableName.Name.String()}
+	if err := parseSequenceOptions(stmt.Options, sequence); err != nil {
+		return nil, err
+	}
+
+	return &parser.DDL{
+		Action:   parser.AlterSequence,
+		Table:    tableName,
+		Sequence: sequence,
+	}, nil
+}
+
+func (p PostgresParser) parseCreateEnumStmt(stmt *pgquery.CreateEnumStmt) (parser.Statement, error) {
+	var name parser.TableName
+	switch len(stmt.TypeName) {
+	case 1:
+		name.Name = parser.NewTableIdent(stmt.TypeName[0].GetString_().Sval)
+	case 2:
+		name.Schema = parser.NewTableIdent(stmt.TypeName[0].GetString_().Sval)
+		name.Name = parser.NewTableIdent(stmt.TypeName[1].GetString_().Sval)
+	default:
+		return nil, fmt.Errorf("unhandled type name in parseCreateEnumStmt: %#v", stmt.TypeName)
+	}
+
+	var values []string
+	for _, node := range stmt.Vals {
+		values = append(values, "'"+strings.ReplaceAll(node.GetString_().Sval, "'", "''")+"'")
+	}
+
+	return &parser.DDL{
+		Action: parser.CreateType,
+		Type: &parser.Type{
+			Name: name,
+			Type: parser.ColumnType{
+				Type:       "enum",
+				EnumValues: values,
+			},
+		},
+	}, nil
+}
+
+func (p PostgresParser) parseCompositeTypeStmt(stmt *pgquery.CompositeTypeStmt) (parser.Statement, error) {
+	typeName, err := p.parseTableName(stmt.Typevar)
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes []*parser.ColumnDefinition
+	for _, node := range stmt.Coldeflist {
+		columnDef := node.GetColumnDef()
+		if columnDef == nil {
+			return nil, fmt.Errorf("unhandled node in parseCompositeTypeStmt: %#v", node)
+		}
+		attribute, _, err := p.parseColumnDef(columnDef, typeName)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, attribute)
+	}
+
+	return &parser.DDL{
+		Action: parser.CreateType,
+		Type: &parser.Type{
+			Name:       typeName,
+			Attributes: attributes,
+		},
+	}, nil
+}
+
+func (p PostgresParser) parseCreateDomainStmt(stmt *pgquery.CreateDomainStmt) (parser.Statement, error) {
+	if stmt.CollClause != nil {
+		return nil, fmt.Errorf("unhandled collation in parseCreateDomainStmt: %#v", stmt.CollClause)
+	}
+
+	var name parser.TableName
+	switch len(stmt.Domainname) {
+	case 1:
+		name.Name = parser.NewTableIdent(stmt.Domainname[0].GetString_().Sval)
+	case 2:
+		name.Schema = parser.NewTableIdent(stmt.Domainname[0].GetString_().Sval)
+		name.Name = parser.NewTableIdent(stmt.Domainname[1].GetString_().Sval)
+	default:
+		return nil, fmt.Errorf("unhandled domain name in parseCreateDomainStmt: %#v", stmt.Domainname)
+	}
+
+	domainType, err := p.parseTypeName(stmt.TypeName)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []*parser.CheckDefinition
+	for _, node := range stmt.Constraints {
+		constraint := node.GetConstraint()
+		if constraint == nil {
+			return nil, fmt.Errorf("unhandled node in parseCreateDomainStmt: %#v", node)
+		}
+		switch constraint.Contype {
+		case pgquery.ConstrType_CONSTR_NULL:
+			domainType.NotNull = parser.NewBoolVal(false)
+		case pgquery.ConstrType_CONSTR_NOTNULL:
+			domainType.NotNull = parser.NewBoolVal(true)
+		case pgquery.ConstrType_CONSTR_DEFAULT:
+			domainType.Default, err = p.parseDefaultValue(constraint.RawExpr)
+			if err != nil {
+				return nil, err
+			}
+		case pgquery.ConstrType_CONSTR_CHECK:
+			check, err := p.parseCheckConstraint(constraint)
+			if err != nil {
+				return nil, err
+			}
+			checks = append(checks, check)
+		default:
+			return nil, fmt.Errorf("unhandled contype in parseCreateDomainStmt: %d", constraint.Contype)
+		}
+	}
+
+	// Unnamed checks are named like PostgreSQL does: <domain>_check, <domain>_check1, ...
+	names := map[string]bool{}
+	for _, check := range checks {
+		names[check.ConstraintName.String()] = true
+	}
+	for _, check := range checks {
+		if check.ConstraintName.String() != "" {
+			continue
+		}
+		checkName := name.Name.String() + "_check"
+		for i := 1; names[checkName]; i++ {
+			checkName = fmt.Sprintf("%s_check%d", name.Name.String(), i)
+		}
+		names[checkName] = true
+		check.ConstraintName = parser.NewColIdent(checkName)
+	}
+
+	return &parser.DDL{
+		Action: parser.CreateDomain,
+		Domain: &parser.Domain{
+			Name:   name,
+			Type:   domainType,
+			Checks: checks,
+		},
+	}, nil
+}
+
+var grantObjectTypes = map[pgquery.ObjectType]string{
+	pgquery.ObjectType_OBJECT_TABLE:     "TABLE",
+	pgquery.ObjectType_OBJECT_SEQUENCE:  "SEQUENCE",
+	pgquery.ObjectType_OBJECT_SCHEMA:    "SCHEMA",
+	pgquery.ObjectType_OBJECT_FUNCTION:  "FUNCTION",
+	pgquery.ObjectType_OBJECT_PROCEDURE: "PROCEDURE",
+}
+
+func (p PostgresParser) parseGrantStmt(stmt *pgquery.GrantStmt) (parser.Statement, error) {
+	if !stmt.IsGrant {
+		return nil, fmt.Errorf("REVOKE is not supported in the desired schema. Remove the GRANT instead: %#v", stmt)
+	}
+	if stmt.Targtype != pgquery.GrantTargetType_ACL_TARGET_OBJECT {
+		return nil, fmt.Errorf("unhandled target in parseGrantStmt: %s", stmt.Targtype)
+	}
+	objectType, ok := grantObjectTypes[stmt.Objtype]
+	if !ok {
+		return nil, fmt.Errorf("unhandled object type in parseGrantStmt: %s", stmt.Objtype)
+	}
+
+	privileges := []string{"ALL"} // no privileges mean ALL PRIVILEGES
+	if len(stmt.Privileges) > 0 {
+		privileges = nil
+		for _, node := range stmt.Privileges {
+			privilege := node.GetAccessPriv()
+			if privilege == nil || len(privilege.Cols) > 0 {
+				return nil, fmt.Errorf("unhandled privilege in parseGrantStmt: %#v", node)
+			}
+			privileges = append(privileges, strings.ToUpper(privilege.PrivName))
+		}
+	}
+
+	var objects []parser.GrantObject
+	for _, node := range stmt.Objects {
+		switch object := node.Node.(type) {
+		case *pgquery.Node_RangeVar:
+			name, err := p.parseTableName(object.RangeVar)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, parser.GrantObject{Name: name})
+		case *pgquery.Node_String_:
+			objects = append(objects, parser.GrantObject{Name: parser.TableName{Name: parser.NewTableIdent(object.String_.Sval)}})
+		case *pgquery.Node_ObjectWithArgs:
+			if object.ObjectWithArgs.ArgsUnspecified {
+				return nil, fmt.Errorf("argument types of a %s must be specified in GRANT: %#v", strings.ToLower(objectType), object.ObjectWithArgs)
+			}
+			var name parser.TableName
+			switch objname := object.ObjectWithArgs.Objname; len(objname) {
+			case 1:
+				name.Name = parser.NewTableIdent(objname[0].GetString_().Sval)
+			case 2:
+				name.Schema = parser.NewTableIdent(objname[0].GetString_().Sval)
+				name.Name = parser.NewTableIdent(objname[1].GetString_().Sval)
+			default:
+				return nil, fmt.Errorf("unhandled function name in parseGrantStmt: %#v", objname)
+			}
+			arguments := []string{}
+			for _, arg := range object.ObjectWithArgs.Objargs {
+				arguments = append(arguments, functionTypeName(arg.GetTypeName()))
+			}
+			objects = append(objects, parser.GrantObject{Name: name, Arguments: arguments})
+		default:
+			return nil, fmt.Errorf("unhandled object in parseGrantStmt: %#v", node)
+		}
+	}
+
+	var grantees []string
+	for _, node := range stmt.Grantees {
+		if role := node.GetRoleSpec(); role != nil && role.Roletype == pgquery.RoleSpecType_ROLESPEC_PUBLIC {
+			grantees = append(grantees, "PUBLIC")
+			continue
+		}
+		grantee, err := parseRoleName(node.GetRoleSpec())
+		if err != nil {
+			return nil, err
+		}
+		grantees = append(grantees, grantee)
+	}
+
+	return &parser.DDL{
+		Action: parser.GrantPrivilege,
+		Grant: &parser.Grant{
+			Privileges:      privileges,
+			ObjectType:      objectType,
+			Objects:         objects,
+			Grantees:        grantees,
+			WithGrantOption: stmt.GrantOption,
+		},
+	}, nil
+}
+
+func (p PostgresParser) parseCreateRoleStmt(stmt *pgquery.CreateRoleStmt) (parser.Statement, error) {
+	role := &parser.Role{
+		Name:            stmt.Role,
+		Inherit:         true,
+		Login:           stmt.StmtType == pgquery.RoleStmtType_ROLESTMT_USER, // CREATE USER implies LOGIN
+		ConnectionLimit: -1,
+	}
+	for _, node := range stmt.Options {
+		option := node.GetDefElem()
+		if option == nil {
+			return nil, fmt.Errorf("unhandled option in parseCreateRoleStmt: %#v", node)
+		}
+		switch option.Defname {
+		case "superuser":
+			role.Superuser = option.Arg.GetBoolean().GetBoolval()
+		case "createdb":
+			role.CreateDB = option.Arg.GetBoolean().GetBoolval()
+		case "createrole":
+			role.CreateRole = option.Arg.GetBoolean().GetBoolval()
+		case "inherit":
+			role.Inherit = option.Arg.GetBoolean().GetBoolval()
+		case "canlogin":
+			role.Login = option.Arg.GetBoolean().GetBoolval()
+		case "isreplication":
+			role.Replication = option.Arg.GetBoolean().GetBoolval()
+		case "bypassrls":
+			role.BypassRLS = option.Arg.GetBoolean().GetBoolval()
+		case "connectionlimit":
+			role.ConnectionLimit = int(option.Arg.GetInteger().GetIval())
+		case "password":
+			// Passwords are managed outside of sqldef
+		default:
+			return nil, fmt.Errorf("unhandled option in parseCreateRoleStmt: %s (use GRANT role TO member for memberships)", option.Defname)
+		}
+	}
+
+	return &parser.DDL{
+		Action: parser.CreateRole,
+		Role:   role,
+	}, nil
+}
+
+func (p PostgresParser) parseGrantRoleStmt(stmt *pgquery.GrantRoleStmt) (parser.Statement, error) {
+	if !stmt.IsGrant {
+		return nil, fmt.Errorf("REVOKE is not supported in the desired schema. Remove the GRANT instead: %#v", stmt)
+	}
+	if len(stmt.Opt) > 0 || stmt.Grantor != nil {
+		return nil, fmt.Errorf("unhandled option in parseGrantRoleStmt: %#v", stmt)
+	}
+
+	var roles, members []string
+	for _, node := range stmt.GrantedRoles {
+		role := node.GetAccessPriv()
+		if role == nil || len(role.Cols) > 0 {
+			return nil, fmt.Errorf("unhandled role in parseGrantRoleStmt: %#v", node)
+		}
+		roles = append(roles, role.PrivName)
+	}
+	for _, node := range stmt.GranteeRoles {
+		member, err := parseRoleName(node.GetRoleSpec())
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+
+	return &parser.DDL{
+		Action: parser.GrantMembership,
+		GrantRole: &parser.GrantRole{
+			Roles:   roles,
+			Members: members,
+		},
+	}, nil
+}
+
+func parseRoleName(role *pgquery.RoleSpec) (string, error) {
+	if role == nil || role.Roletype != pgquery.RoleSpecType_ROLESPEC_CSTRING {
+		return "", fmt.Errorf("unhandled role in parseRoleName: %#v", role)
+	}
+	return role.Rolename, nil
+}
+
+func parseSequenceOptions(options []*pgquery.Node, sequence *parser.Sequence) error {
+	specified := parser.BoolVal(true)
+	var err error
+	for _, node := range options {
+		option := node.GetDefElem()
+		if option == nil {
+			return fmt.Errorf("unhandled option in parseSequenceOptions: %#v", node)
+		}
+		switch option.Defname {
+		case "as":
+			typeName := functionTypeName(option.Arg.GetTypeName())
+			if name, ok := sequenceTypes[typeName]; ok {
+				typeName = name
+			}
+			sequence.Type = typeName
+		case "increment":
+			sequence.IncrementBy, err = parseSequenceValue(option.Arg)
+		case "minvalue":
+			if option.Arg == nil {
+				sequence.NoMinValue = &specified
+			} else {
+				sequence.MinValue, err = parseSequenceValue(option.Arg)
+			}
+		case "maxvalue":
+			if option.Arg == nil {
+				sequence.NoMaxValue = &specified
+			} else {
+				sequence.MaxValue, err = parseSequenceValue(option.Arg)
+			}
+		case "start":
+			sequence.StartWith, err = parseSequenceValue(option.Arg)
+		case "cache":
+			sequence.Cache, err = parseSequenceValue(option.Arg)
+		case "cycle":
+			if option.Arg.GetBoolean().GetBoolval() {
+				sequence.Cycle = &specified
+			} else {
+				sequence.NoCycle = &specified
+			}
+		case "owned_by":
+			var names []string
+			for _, name := range option.Arg.GetList().GetItems() {
+				names = append(names, name.GetString_().GetSval())
+			}
+			if len(names) != 1 || names[0] != "none" {
+				sequence.OwnedBy = strings.Join(names, ".")
+			}
+		default:
+			return fmt.Errorf("unhandled option in parseSequenceOptions: %s", option.Defname)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseSequenceValue(node *pgquery.Node) (*parser.SQLVal, error) {
+	switch value := node.GetNode().(type) {
+	case *pgquery.Node_Integer:
+		return parser.NewIntVal([]byte(fmt.Sprint(value.Integer.Ival))), nil
+	case *pgquery.Node_Float: // Integers out of the range of int4
+		return parser.NewIntVal([]byte(value.Float.Fval)), nil
+	default:
+		return nil, fmt.Errorf("unhandled sequence value: %#v", node)
+	}
+}
+
+// Type name of a function argument or a return type in its signature, e.g. int4 or public.my_type[]
+func functionTypeName(typeName *pgquery.TypeName) string {
+	var names []string
+	for _, name := range typeName.Names {
+		if sval := name.GetString_().GetSval(); sval != "pg_catalog" {
+			names = append(names, sval)
+		}
+	}
+	return strings.Join(names, ".") + strings.Repeat("[]", len(typeName.ArrayBounds))
+}
+
 // This is a workaround to handle cases where PostgreSQL automatically adds or removes type casting.
 //
 // Example:
@@ -14,7 +14,11 @@ type Sqlite3Database struct {
 }
 
 func NewDatabase(config database.Config) (database.Database, error) {
-	db, err := sql.Open("sqlite", config.DbName)
+	dsn := config.DbName
+	if len(config.Params) > 0 {
+		dsn += "?" + config.Params.Encode() // e.g. _pragma=busy_timeout(5000)
+	}
+	db, err := sql.Open("sqlite", dsn)
 	if err != nil {
 		return nil, err
 	}
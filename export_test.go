@@ -0,0 +1,8 @@
+package sqldef
+
+import "github.com/sqldef/sqldef/v2/schema"
+
+// Statements of Result to be executed, exported for tests
+func (r *Result) ExecutedStatements() []schema.Change {
+	return r.executedStatements()
+}
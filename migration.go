@@ -0,0 +1,200 @@
+package sqldef
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sqldef/sqldef/v2/schema"
+)
+
+// Formats of migration files written by WriteMigration
+const (
+	MigrationFormatGolangMigrate = "golang-migrate"
+	MigrationFormatFlyway        = "flyway"
+	MigrationFormatGoose         = "goose"
+	MigrationFormatDbmate        = "dbmate"
+)
+
+var migrationFormats = []string{MigrationFormatGolangMigrate, MigrationFormatFlyway, MigrationFormatGoose, MigrationFormatDbmate}
+
+// Name of migration files following their version
+const migrationName = "sqldef"
+
+// A versioned migration that is executed in a single transaction unless it's non-transactional
+type migration struct {
+	version       string
+	up            []schema.Change
+	down          []schema.Change
+	downWarnings  []string
+	transactional bool
+}
+
+// Write the executed changes of result to dir as migration files of the format, versioned by the timestamp of now.
+// Rollback of result is written as down migrations. Since most migration runners cannot run a non-transactional
+// statement like `CREATE INDEX CONCURRENTLY` with others, it's written as a separate migration.
+// This returns the paths of written files.
+func WriteMigration(result *Result, dir string, format string, now time.Time) ([]string, error) {
+	if !slices.Contains(migrationFormats, format) {
+		return nil, fmt.Errorf("unknown migration format '%s' (expected one of: %s)", format, strings.Join(migrationFormats, ", "))
+	}
+	migrations := splitMigrations(result, now)
+	if len(migrations) == 0 {
+		return nil, nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	var paths []string
+	var err error
+	addFile := func(name string, content string) {
+		path := filepath.Join(dir, name)
+		if err == nil {
+			err = os.WriteFile(path, []byte(content), 0644)
+			paths = append(paths, path)
+		}
+	}
+	for _, m := range migrations {
+		switch format {
+		case MigrationFormatGolangMigrate:
+			addFile(fmt.Sprintf("%s_%s.up.sql", m.version, migrationName), formatMigrationDDLs(m.up, nil))
+			addFile(fmt.Sprintf("%s_%s.down.sql", m.version, migrationName), formatMigrationDDLs(m.down, m.downWarnings))
+		case MigrationFormatFlyway:
+			addFile(fmt.Sprintf("V%s__%s.sql", m.version, migrationName), formatMigrationDDLs(m.up, nil))
+			if !m.transactional {
+				addFile(fmt.Sprintf("V%s__%s.sql.conf", m.version, migrationName), "executeInTransaction=false\n")
+			}
+			if len(m.down) > 0 {
+				addFile(fmt.Sprintf("U%s__%s.sql", m.version, migrationName), formatMigrationDDLs(m.down, m.downWarnings))
+			}
+		case MigrationFormatGoose:
+			addFile(fmt.Sprintf("%s_%s.sql", m.version, migrationName), formatGooseMigration(m))
+		case MigrationFormatDbmate:
+			addFile(fmt.Sprintf("%s_%s.sql", m.version, migrationName), formatDbmateMigration(m))
+		}
+	}
+	return paths, err
+}
+
+// Split the executed changes into migrations so that each non-transactional change is run alone.
+// Each migration is rolled back by the changes of Rollback that rollbackMigration assigns to it.
+func splitMigrations(result *Result, now time.Time) []migration {
+	var migrations []migration
+	result.eachChange(func(change schema.Change, skipped bool) bool {
+		if skipped {
+			return true
+		}
+		if len(migrations) == 0 || !change.Transactional || !migrations[len(migrations)-1].transactional {
+			migrations = append(migrations, migration{
+				version:       now.Add(time.Duration(len(migrations)) * time.Second).Format("20060102150405"),
+				transactional: change.Transactional,
+			})
+		}
+		m := &migrations[len(migrations)-1]
+		m.up = append(m.up, change)
+		return true
+	})
+	if len(migrations) == 0 {
+		return nil
+	}
+
+	for _, change := range result.Rollback {
+		m := &migrations[rollbackMigration(migrations, change)]
+		m.down = append(m.down, change)
+	}
+	for i := range migrations {
+		m := &migrations[i]
+		m.downWarnings = rollbackWarnings(m.up, m.down)
+		if result.mergeAlterTable {
+			// Skipped changes are already excluded, which don't end merging anyway
+			m.up = schema.MergeAlterTables(m.up, nil)
+			m.down = schema.MergeAlterTables(m.down, nil)
+		}
+	}
+	return migrations
+}
+
+// Index of the migration that a change of Rollback reverts: the one changing the same object like isSkippedDrop,
+// or the first one changing its table. Rollback of the other objects is in the first migration.
+func rollbackMigration(migrations []migration, rollback schema.Change) int {
+	table := -1
+	for i, m := range migrations {
+		for _, change := range m.up {
+			if change.Table == rollback.Table && change.Name == rollback.Name {
+				return i
+			}
+			if table < 0 && rollback.Table != "" && change.Table == rollback.Table {
+				table = i
+			}
+		}
+	}
+	return max(table, 0)
+}
+
+func formatMigrationDDLs(changes []schema.Change, warnings []string) string {
+	var out strings.Builder
+	for _, warning := range warnings {
+		fmt.Fprintf(&out, "-- WARNING: %s\n", warning)
+	}
+	for _, change := range changes {
+		fmt.Fprintf(&out, "%s;\n", change.DDL)
+	}
+	return out.String()
+}
+
+// Format a migration with annotations of goose https://github.com/pressly/goose
+func formatGooseMigration(m migration) string {
+	var out strings.Builder
+	if !m.transactional || !isTransactional(m.down) {
+		out.WriteString("-- +goose NO TRANSACTION\n")
+	}
+	out.WriteString("-- +goose Up\n")
+	writeGooseDDLs(&out, m.up)
+	out.WriteString("\n-- +goose Down\n")
+	for _, warning := range m.downWarnings {
+		fmt.Fprintf(&out, "-- WARNING: %s\n", warning)
+	}
+	writeGooseDDLs(&out, m.down)
+	return out.String()
+}
+
+func writeGooseDDLs(out *strings.Builder, changes []schema.Change) {
+	for _, change := range changes {
+		if strings.Contains(change.DDL, ";") { // e.g. CREATE FUNCTION, which must not be split by semicolons
+			fmt.Fprintf(out, "-- +goose StatementBegin\n%s;\n-- +goose StatementEnd\n", change.DDL)
+		} else {
+			fmt.Fprintf(out, "%s;\n", change.DDL)
+		}
+	}
+}
+
+// Format a migration with annotations of dbmate https://github.com/amacneil/dbmate
+func formatDbmateMigration(m migration) string {
+	var out strings.Builder
+	out.WriteString("-- migrate:up")
+	if !m.transactional {
+		out.WriteString(" transaction:false")
+	}
+	out.WriteString("\n")
+	out.WriteString(formatMigrationDDLs(m.up, nil))
+	out.WriteString("\n-- migrate:down")
+	if !isTransactional(m.down) {
+		out.WriteString(" transaction:false")
+	}
+	out.WriteString("\n")
+	out.WriteString(formatMigrationDDLs(m.down, m.downWarnings))
+	return out.String()
+}
+
+func isTransactional(changes []schema.Change) bool {
+	for _, change := range changes {
+		if !change.Transactional {
+			return false
+		}
+	}
+	return true
+}
@@ -421,6 +421,15 @@ type DDL struct {
 	Comment       *Comment
 	Extension     *Extension
 	Schema        *Schema
+	Function      *Function
+	Event         *Event
+	Sequence      *Sequence
+	Domain        *Domain
+	Grant         *Grant
+	Role          *Role
+	GrantRole     *GrantRole
+	Owner         string // for AlterOwner
+	RowSecurity   string // for AlterRowSecurity: ENABLE, DISABLE, FORCE or NO FORCE
 }
 
 type DDLAction int
@@ -440,6 +449,16 @@ const (
 	CreateType
 	CreateView
 	CreateSchema
+	CreateFunction
+	CreateSequence
+	AlterSequence
+	CreateDomain
+	GrantPrivilege
+	CreateRole
+	GrantMembership
+	AlterOwner
+	AlterRowSecurity
+	CreateEvent
 )
 
 // View types
@@ -499,9 +518,11 @@ func (node *PartitionSpec) Format(buf *nodeBuffer) {
 
 // PartitionDefinition describes a very minimal partition definition
 type PartitionDefinition struct {
-	Name     ColIdent
-	Limit    Expr
-	Maxvalue bool
+	Name       ColIdent
+	Limit      Expr
+	Maxvalue   bool
+	Definition string // for MySQL CREATE TABLE, the definition as written, e.g. `PARTITION p0 VALUES LESS THAN (10)`
+	Values     string // for MySQL CREATE TABLE, the normalized values and options compared with the dumped ones
 }
 
 // Format formats the node
@@ -521,6 +542,24 @@ type TableSpec struct {
 	Checks      []*CheckDefinition
 	Exclusions  []*ExclusionDefinition // for Postgres
 	Options     map[string]string
+	PartitionBy string           // for Postgres, the partition key like `RANGE(created_at)`
+	PartitionOf *PartitionOf     // for Postgres
+	Partition   *PartitionOption // for MySQL
+}
+
+// PartitionOption is `PARTITION BY` of a table in MySQL
+type PartitionOption struct {
+	Clause      string // The clause as written, e.g. `PARTITION BY HASH (id) PARTITIONS 4`
+	Type        string // RANGE, LIST, HASH or KEY
+	Scheme      string // The normalized type, expression and subpartitioning, which are changed only by repartitioning the table
+	Partitions  int    // PARTITIONS n, or the number of Definitions
+	Definitions []*PartitionDefinition
+}
+
+// PartitionOf is `PARTITION OF parent FOR VALUES ...` of a partition in PostgreSQL
+type PartitionOf struct {
+	Parent TableName
+	Bound  string // e.g. `FOR VALUES IN (1, 2)` or `DEFAULT`
 }
 
 // Format formats the node.
@@ -858,6 +897,7 @@ type IndexSpec struct {
 	Constraint        bool
 	Clustered         bool // for MSSQL
 	ColumnStore       bool // for MSSQL
+	Concurrently      bool // for Postgres
 	Included          []ColIdent
 	Where             *Where
 	Options           []*IndexOption
@@ -899,6 +939,23 @@ type Schema struct {
 	Name string
 }
 
+// Function is a function or a procedure. Arguments and Returns are the identity of it,
+// and Definition is the normalized statement used to compare its body and options.
+type Function struct {
+	Name       TableName
+	Procedure  bool
+	Arguments  []string
+	Returns    string
+	Definition string
+}
+
+// Event is a MySQL event. Starts is separated from Definition since it defaults to the time the event is created.
+type Event struct {
+	Name       TableName
+	Starts     string
+	Definition string
+}
+
 type Permissive string
 
 // Show represents a show statement.
@@ -1051,6 +1108,8 @@ type View struct {
 	SecurityType string
 	Name         TableName
 	Definition   SelectStatement
+	WithNoData   bool // for materialized views
+	Normalized   bool // Definition is normalized by the PostgreSQL parser and comparable to the dumped one
 }
 
 type Trigger struct {
@@ -1059,11 +1118,55 @@ type Trigger struct {
 	Time      string
 	Event     []string
 	Body      []Statement
+	// PostgreSQL triggers don't have a body. Instead, Definition is the normalized statement used to compare them.
+	Constraint bool
+	Definition string
 }
 
 type Type struct {
-	Name TableName // workaround: using TableName to handle schema
-	Type ColumnType
+	Name       TableName // workaround: using TableName to handle schema
+	Type       ColumnType
+	Attributes []*ColumnDefinition // attributes of a composite type
+}
+
+// Domain is a PostgreSQL domain. Type holds its base type with the default and NOT NULL.
+type Domain struct {
+	Name   TableName
+	Type   ColumnType
+	Checks []*CheckDefinition
+}
+
+// Grant is a PostgreSQL GRANT of privileges on objects to roles
+type Grant struct {
+	Privileges      []string // e.g. SELECT, or ALL for ALL PRIVILEGES
+	ObjectType      string   // TABLE, SEQUENCE, SCHEMA, FUNCTION or PROCEDURE
+	Objects         []GrantObject
+	Grantees        []string // role names, or PUBLIC
+	WithGrantOption bool
+}
+
+type GrantObject struct {
+	Name      TableName // Name is the schema name for SCHEMA
+	Arguments []string  // argument types of functions and procedures
+}
+
+// Role is a PostgreSQL role. Its password is never compared nor dumped.
+type Role struct {
+	Name            string
+	Superuser       bool
+	CreateDB        bool
+	CreateRole      bool
+	Inherit         bool
+	Login           bool
+	Replication     bool
+	BypassRLS       bool
+	ConnectionLimit int // -1 for no limit
+}
+
+// GrantRole is `GRANT role TO member` of PostgreSQL
+type GrantRole struct {
+	Roles   []string
+	Members []string
 }
 
 type Comment struct {
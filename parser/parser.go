@@ -3131,14 +3131,15 @@ yydefault:
 				Table:   yyDollar[7].tableName,
 				NewName: yyDollar[7].tableName,
 				IndexSpec: &IndexSpec{
-					Name:      yyDollar[5].colIdent,
-					Type:      NewColIdent(""),
-					Unique:    bool(yyDollar[2].boolVals[0]),
-					Clustered: bool(yyDollar[2].boolVals[1]),
-					Included:  yyDollar[11].columns,
-					Where:     NewWhere(WhereStr, yyDollar[12].expr),
-					Options:   yyDollar[13].indexOptions,
-					Partition: yyDollar[14].indexPartition,
+					Name:         yyDollar[5].colIdent,
+					Type:         NewColIdent(""),
+					Unique:       bool(yyDollar[2].boolVals[0]),
+					Clustered:    bool(yyDollar[2].boolVals[1]),
+					Concurrently: true,
+					Included:     yyDollar[11].columns,
+					Where:        NewWhere(WhereStr, yyDollar[12].expr),
+					Options:      yyDollar[13].indexOptions,
+					Partition:    yyDollar[14].indexPartition,
 				},
 				IndexCols: yyDollar[9].indexColumnsOrExpression.IndexCols,
 				IndexExpr: yyDollar[9].indexColumnsOrExpression.IndexExpr,
@@ -3146,7 +3147,7 @@ yydefault:
 		}
 	case 10:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser/parser.y:486
+//line parser/parser.y:487
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
@@ -3163,7 +3164,7 @@ yydefault:
 		}
 	case 11:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:502
+//line parser/parser.y:503
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
@@ -3181,7 +3182,7 @@ yydefault:
 		}
 	case 12:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser/parser.y:519
+//line parser/parser.y:520
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
@@ -3202,7 +3203,7 @@ yydefault:
 		}
 	case 13:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:538
+//line parser/parser.y:539
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateView,
@@ -3215,7 +3216,7 @@ yydefault:
 		}
 	case 14:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser/parser.y:549
+//line parser/parser.y:550
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateView,
@@ -3229,7 +3230,7 @@ yydefault:
 		}
 	case 15:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:561
+//line parser/parser.y:562
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateView,
@@ -3242,7 +3243,7 @@ yydefault:
 		}
 	case 16:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser/parser.y:572
+//line parser/parser.y:573
 		{
 			yyVAL.statement = &DDL{
 				Action: CreatePolicy,
@@ -3259,7 +3260,7 @@ yydefault:
 		}
 	case 17:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser/parser.y:588
+//line parser/parser.y:589
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateTrigger,
@@ -3274,7 +3275,7 @@ yydefault:
 		}
 	case 18:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:602
+//line parser/parser.y:603
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateTrigger,
@@ -3289,7 +3290,7 @@ yydefault:
 		}
 	case 19:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:616
+//line parser/parser.y:617
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateTrigger,
@@ -3304,7 +3305,7 @@ yydefault:
 		}
 	case 20:
 		yyDollar = yyS[yypt-16 : yypt+1]
-//line parser/parser.y:629
+//line parser/parser.y:630
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateTrigger,
@@ -3319,7 +3320,7 @@ yydefault:
 		}
 	case 21:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:643
+//line parser/parser.y:644
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateType,
@@ -3331,13 +3332,13 @@ yydefault:
 		}
 	case 22:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:654
+//line parser/parser.y:655
 		{
 			yyVAL.statement = &DDL{Action: CreateTable, NewName: yyDollar[5].tableName, TableSpec: &TableSpec{}}
 		}
 	case 23:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser/parser.y:660
+//line parser/parser.y:661
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndex,
@@ -3353,7 +3354,7 @@ yydefault:
 		}
 	case 24:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:674
+//line parser/parser.y:675
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddPrimaryKey,
@@ -3369,7 +3370,7 @@ yydefault:
 		}
 	case 25:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:688
+//line parser/parser.y:689
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndex,
@@ -3390,7 +3391,7 @@ yydefault:
 		}
 	case 26:
 		yyDollar = yyS[yypt-14 : yypt+1]
-//line parser/parser.y:708
+//line parser/parser.y:709
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndex,
@@ -3410,7 +3411,7 @@ yydefault:
 		}
 	case 27:
 		yyDollar = yyS[yypt-14 : yypt+1]
-//line parser/parser.y:726
+//line parser/parser.y:727
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndex,
@@ -3430,7 +3431,7 @@ yydefault:
 		}
 	case 28:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:744
+//line parser/parser.y:745
 		{
 			yyVAL.statement = &DDL{
 				Action:     AddForeignKey,
@@ -3441,7 +3442,7 @@ yydefault:
 		}
 	case 29:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:753
+//line parser/parser.y:754
 		{
 			yyVAL.statement = &DDL{
 				Action:     AddForeignKey,
@@ -3452,7 +3453,7 @@ yydefault:
 		}
 	case 32:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:768
+//line parser/parser.y:769
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3462,43 +3463,43 @@ yydefault:
 		}
 	case 33:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:776
+//line parser/parser.y:777
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
 	case 34:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser/parser.y:783
+//line parser/parser.y:784
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:789
+//line parser/parser.y:790
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:793
+//line parser/parser.y:794
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:799
+//line parser/parser.y:800
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:803
+//line parser/parser.y:804
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 39:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:810
+//line parser/parser.y:811
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3512,7 +3513,7 @@ yydefault:
 		}
 	case 40:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:822
+//line parser/parser.y:823
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3524,107 +3525,107 @@ yydefault:
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:834
+//line parser/parser.y:835
 		{
 			yyVAL.str = InsertStr
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:838
+//line parser/parser.y:839
 		{
 			yyVAL.str = ReplaceStr
 		}
 	case 43:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:844
+//line parser/parser.y:845
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, From: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
 	case 44:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:850
+//line parser/parser.y:851
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
 	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:854
+//line parser/parser.y:855
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
 	case 46:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:858
+//line parser/parser.y:859
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:863
+//line parser/parser.y:864
 		{
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:864
+//line parser/parser.y:865
 		{
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:868
+//line parser/parser.y:869
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
 	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:872
+//line parser/parser.y:873
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
 	case 51:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:877
+//line parser/parser.y:878
 		{
 			yyVAL.partitions = nil
 		}
 	case 52:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:881
+//line parser/parser.y:882
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
 	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:887
+//line parser/parser.y:888
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
 	case 54:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:891
+//line parser/parser.y:892
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
 	case 55:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:895
+//line parser/parser.y:896
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
 	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:899
+//line parser/parser.y:900
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
 	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:905
+//line parser/parser.y:906
 		{
 			yyVAL.statement = &Declare{Type: declareVariable, Variables: yyDollar[2].localVariables}
 		}
 	case 58:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:909
+//line parser/parser.y:910
 		{
 			yyVAL.statement = &Declare{
 				Type: declareCursor,
@@ -3637,37 +3638,37 @@ yydefault:
 		}
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:922
+//line parser/parser.y:923
 		{
 			yyVAL.localVariables = []*LocalVariable{yyDollar[1].localVariable}
 		}
 	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:926
+//line parser/parser.y:927
 		{
 			yyVAL.localVariables = append(yyVAL.localVariables, yyDollar[3].localVariable)
 		}
 	case 61:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:932
+//line parser/parser.y:933
 		{
 			yyVAL.localVariable = &LocalVariable{Name: yyDollar[1].colIdent, DataType: yyDollar[2].columnType}
 		}
 	case 62:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:937
+//line parser/parser.y:938
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:941
+//line parser/parser.y:942
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:947
+//line parser/parser.y:948
 		{
 			yyVAL.statement = &Cursor{
 				Action:     OpenStr,
@@ -3676,7 +3677,7 @@ yydefault:
 		}
 	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:954
+//line parser/parser.y:955
 		{
 			yyVAL.statement = &Cursor{
 				Action:     CloseStr,
@@ -3685,7 +3686,7 @@ yydefault:
 		}
 	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:961
+//line parser/parser.y:962
 		{
 			yyVAL.statement = &Cursor{
 				Action:     DeallocateStr,
@@ -3694,7 +3695,7 @@ yydefault:
 		}
 	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:968
+//line parser/parser.y:969
 		{
 			yyVAL.statement = &Cursor{
 				Action:     FetchStr,
@@ -3704,7 +3705,7 @@ yydefault:
 		}
 	case 68:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:976
+//line parser/parser.y:977
 		{
 			yyVAL.statement = &Cursor{
 				Action:     FetchStr,
@@ -3715,37 +3716,37 @@ yydefault:
 		}
 	case 69:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:986
+//line parser/parser.y:987
 		{
 			yyVAL.str = ""
 		}
 	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:990
+//line parser/parser.y:991
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:994
+//line parser/parser.y:995
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:998
+//line parser/parser.y:999
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1002
+//line parser/parser.y:1003
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 74:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1008
+//line parser/parser.y:1009
 		{
 			yyVAL.statement = &While{
 				Condition:  yyDollar[2].expr,
@@ -3754,7 +3755,7 @@ yydefault:
 		}
 	case 75:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:1015
+//line parser/parser.y:1016
 		{
 			yyVAL.statement = &While{
 				Condition:  yyDollar[2].expr,
@@ -3764,25 +3765,25 @@ yydefault:
 		}
 	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1025
+//line parser/parser.y:1026
 		{
 			yyVAL.blockStatement = []Statement{yyDollar[1].statement}
 		}
 	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1029
+//line parser/parser.y:1030
 		{
 			yyVAL.blockStatement = append(yyVAL.blockStatement, yyDollar[2].statement)
 		}
 	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1033
+//line parser/parser.y:1034
 		{
 			yyVAL.blockStatement = append(yyVAL.blockStatement, yyDollar[3].statement)
 		}
 	case 79:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:1040
+//line parser/parser.y:1041
 		{
 			yyVAL.statement = &If{
 				Condition:    yyDollar[2].expr,
@@ -3792,7 +3793,7 @@ yydefault:
 		}
 	case 80:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:1049
+//line parser/parser.y:1050
 		{
 			yyVAL.statement = &If{
 				Condition:    yyDollar[2].expr,
@@ -3802,7 +3803,7 @@ yydefault:
 		}
 	case 81:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:1057
+//line parser/parser.y:1058
 		{
 			yyVAL.statement = &If{
 				Condition:      yyDollar[2].expr,
@@ -3813,208 +3814,208 @@ yydefault:
 		}
 	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1068
+//line parser/parser.y:1069
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
 	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1072
+//line parser/parser.y:1073
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
 	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1078
+//line parser/parser.y:1079
 		{
 			yyVAL.setExpr = yyDollar[3].setExpr
 		}
 	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1082
+//line parser/parser.y:1083
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
 		}
 	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1086
+//line parser/parser.y:1087
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
 		}
 	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1092
+//line parser/parser.y:1093
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
 		}
 	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1096
+//line parser/parser.y:1097
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
 		}
 	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1100
+//line parser/parser.y:1101
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
 		}
 	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1104
+//line parser/parser.y:1105
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
 		}
 	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1110
+//line parser/parser.y:1111
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1114
+//line parser/parser.y:1115
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1120
+//line parser/parser.y:1121
 		{
 			yyVAL.str = SessionStr
 		}
 	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1124
+//line parser/parser.y:1125
 		{
 			yyVAL.str = GlobalStr
 		}
 	case 95:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1129
+//line parser/parser.y:1130
 		{
 		}
 	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1130
+//line parser/parser.y:1131
 		{
 		}
 	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1134
+//line parser/parser.y:1135
 		{
 		}
 	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1135
+//line parser/parser.y:1136
 		{
 		}
 	case 99:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1136
+//line parser/parser.y:1137
 		{
 		}
 	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1137
+//line parser/parser.y:1138
 		{
 		}
 	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1138
+//line parser/parser.y:1139
 		{
 		}
 	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1139
+//line parser/parser.y:1140
 		{
 		}
 	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1140
+//line parser/parser.y:1141
 		{
 		}
 	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1144
+//line parser/parser.y:1145
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1148
+//line parser/parser.y:1149
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1152
+//line parser/parser.y:1153
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1156
+//line parser/parser.y:1157
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
 	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1162
+//line parser/parser.y:1163
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1166
+//line parser/parser.y:1167
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1170
+//line parser/parser.y:1171
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1175
+//line parser/parser.y:1176
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1181
+//line parser/parser.y:1182
 		{
 			yyVAL.strs = []string{string(yyDollar[1].str)}
 		}
 	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1185
+//line parser/parser.y:1186
 		{
 			yyVAL.strs = append(yyVAL.strs, string(yyDollar[3].str))
 		}
 	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1191
+//line parser/parser.y:1192
 		{
 			yyVAL.blockStatement = []Statement{yyDollar[1].statement}
 		}
 	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1195
+//line parser/parser.y:1196
 		{
 			yyVAL.blockStatement = append(yyVAL.blockStatement, yyDollar[2].statement)
 		}
 	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1201
+//line parser/parser.y:1202
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1213
+//line parser/parser.y:1214
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -4024,7 +4025,7 @@ yydefault:
 		}
 	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1225
+//line parser/parser.y:1226
 		{
 			yyVAL.statement = &BeginEnd{
 				Statements: []Statement{yyDollar[2].statement},
@@ -4032,202 +4033,202 @@ yydefault:
 		}
 	case 128:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1232
+//line parser/parser.y:1233
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1234
+//line parser/parser.y:1235
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 130:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1237
+//line parser/parser.y:1238
 		{
 			yyVAL.bytes = nil
 		}
 	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1241
+//line parser/parser.y:1242
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
 	case 132:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1245
+//line parser/parser.y:1246
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
 	case 133:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1250
+//line parser/parser.y:1251
 		{
 			yyVAL.bytes = nil
 		}
 	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1254
+//line parser/parser.y:1255
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
 	case 135:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1258
+//line parser/parser.y:1259
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
 	case 136:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1262
+//line parser/parser.y:1263
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
 	case 137:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1266
+//line parser/parser.y:1267
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
 	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1270
+//line parser/parser.y:1271
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
 	case 139:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1275
+//line parser/parser.y:1276
 		{
 			yyVAL.expr = nil
 		}
 	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1279
+//line parser/parser.y:1280
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
 	case 141:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1284
+//line parser/parser.y:1285
 		{
 			yyVAL.expr = nil
 		}
 	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1288
+//line parser/parser.y:1289
 		{
 			yyVAL.expr = yyDollar[3].expr
 		}
 	case 143:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1293
+//line parser/parser.y:1294
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1297
+//line parser/parser.y:1298
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 145:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1302
+//line parser/parser.y:1303
 		{
 			yyVAL.bytes = nil
 		}
 	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1306
+//line parser/parser.y:1307
 		{
 			yyVAL.bytes = nil
 		}
 	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1312
+//line parser/parser.y:1313
 		{
 			yyVAL.ddl = &DDL{Action: CreateTable, NewName: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
 	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1319
+//line parser/parser.y:1320
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
 		}
 	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1325
+//line parser/parser.y:1326
 		{
 			yyVAL.TableSpec = &TableSpec{}
 		}
 	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1329
+//line parser/parser.y:1330
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.addColumn(yyDollar[1].columnDefinition)
 		}
 	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1334
+//line parser/parser.y:1335
 		{
 			yyVAL.TableSpec.addColumn(yyDollar[3].columnDefinition)
 		}
 	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1338
+//line parser/parser.y:1339
 		{
 			yyVAL.TableSpec.addIndex(yyDollar[3].indexDefinition)
 		}
 	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1342
+//line parser/parser.y:1343
 		{
 			yyVAL.TableSpec.addForeignKey(yyDollar[3].foreignKeyDefinition)
 		}
 	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1346
+//line parser/parser.y:1347
 		{
 			yyVAL.TableSpec.addIndex(yyDollar[3].indexDefinition)
 		}
 	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1350
+//line parser/parser.y:1351
 		{
 			yyVAL.TableSpec.addIndex(yyDollar[3].indexDefinition)
 		}
 	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1354
+//line parser/parser.y:1355
 		{
 			yyVAL.TableSpec.addCheck(yyDollar[3].checkDefinition)
 		}
 	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1360
+//line parser/parser.y:1361
 		{
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1365
+//line parser/parser.y:1366
 		{
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
 	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1370
+//line parser/parser.y:1371
 		{
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
 	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1376
+//line parser/parser.y:1377
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
@@ -4235,13 +4236,13 @@ yydefault:
 		}
 	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1387
+//line parser/parser.y:1388
 		{
 			yyVAL.columnType = ColumnType{Type: yyDollar[1].colIdent.val}
 		}
 	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1393
+//line parser/parser.y:1394
 		{
 			yyDollar[1].columnType.NotNull = nil
 			yyDollar[1].columnType.Default = nil
@@ -4256,91 +4257,91 @@ yydefault:
 		}
 	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1406
+//line parser/parser.y:1407
 		{
 			yyDollar[1].columnType.NotNull = NewBoolVal(false)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1411
+//line parser/parser.y:1412
 		{
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1416
+//line parser/parser.y:1417
 		{
 			yyDollar[1].columnType.Default = &DefaultDefinition{ValueOrExpression: yyDollar[2].defaultValueOrExpression}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 170:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1421
+//line parser/parser.y:1422
 		{
 			yyDollar[1].columnType.Default = &DefaultDefinition{ConstraintName: yyDollar[3].colIdent, ValueOrExpression: yyDollar[4].defaultValueOrExpression}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1427
+//line parser/parser.y:1428
 		{
 			yyDollar[1].columnType.Srid = &SridDefinition{Value: yyDollar[2].optVal}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 172:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1432
+//line parser/parser.y:1433
 		{
 			yyDollar[1].columnType.OnUpdate = yyDollar[4].optVal
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1437
+//line parser/parser.y:1438
 		{
 			yyDollar[1].columnType.Autoincrement = BoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1442
+//line parser/parser.y:1443
 		{
 			yyDollar[1].columnType.Autoincrement = BoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1447
+//line parser/parser.y:1448
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyPrimary
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1452
+//line parser/parser.y:1453
 		{
 			yyDollar[1].columnType.KeyOpt = colKey
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1457
+//line parser/parser.y:1458
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyUniqueKey
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1462
+//line parser/parser.y:1463
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyUnique
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 179:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:1467
+//line parser/parser.y:1468
 		{
 			yyDollar[1].columnType.Check = &CheckDefinition{
 				Where:             *NewWhere(WhereStr, yyDollar[5].expr),
@@ -4351,7 +4352,7 @@ yydefault:
 		}
 	case 180:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:1476
+//line parser/parser.y:1477
 		{
 			yyDollar[1].columnType.Check = &CheckDefinition{
 				ConstraintName:    yyDollar[3].colIdent,
@@ -4363,21 +4364,21 @@ yydefault:
 		}
 	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1486
+//line parser/parser.y:1487
 		{
 			yyDollar[1].columnType.Comment = NewStrVal(yyDollar[3].bytes)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 182:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1491
+//line parser/parser.y:1492
 		{
 			yyDollar[1].columnType.References = String(yyDollar[3].tableName)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 183:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1496
+//line parser/parser.y:1497
 		{
 			yyDollar[1].columnType.References = String(yyDollar[3].tableName)
 			yyDollar[1].columnType.ReferenceNames = yyDollar[5].columns
@@ -4385,7 +4386,7 @@ yydefault:
 		}
 	case 184:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:1503
+//line parser/parser.y:1504
 		{
 			yyDollar[1].columnType.References = String(yyDollar[3].tableName)
 			yyDollar[1].columnType.ReferenceNames = yyDollar[5].columns
@@ -4394,7 +4395,7 @@ yydefault:
 		}
 	case 185:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:1510
+//line parser/parser.y:1511
 		{
 			yyDollar[1].columnType.References = String(yyDollar[3].tableName)
 			yyDollar[1].columnType.ReferenceNames = yyDollar[5].columns
@@ -4403,35 +4404,35 @@ yydefault:
 		}
 	case 186:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1518
+//line parser/parser.y:1519
 		{
 			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[4].expr, GeneratedType: "VIRTUAL"}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 187:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1523
+//line parser/parser.y:1524
 		{
 			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[4].expr, GeneratedType: "STORED"}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 188:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:1528
+//line parser/parser.y:1529
 		{
 			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[6].expr, GeneratedType: "VIRTUAL"}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 189:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:1533
+//line parser/parser.y:1534
 		{
 			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[6].expr, GeneratedType: "STORED"}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 190:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:1539
+//line parser/parser.y:1540
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Behavior: yyDollar[3].str}
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
@@ -4439,7 +4440,7 @@ yydefault:
 		}
 	case 191:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:1545
+//line parser/parser.y:1546
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Behavior: yyDollar[3].str, Sequence: yyDollar[7].sequence}
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
@@ -4447,7 +4448,7 @@ yydefault:
 		}
 	case 192:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:1551
+//line parser/parser.y:1552
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Sequence: &Sequence{StartWith: NewIntVal(yyDollar[4].bytes), IncrementBy: NewIntVal(yyDollar[6].bytes)}, NotForReplication: false}
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
@@ -4455,358 +4456,358 @@ yydefault:
 		}
 	case 193:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1558
+//line parser/parser.y:1559
 		{
 			yyDollar[1].columnType.Identity.NotForReplication = true
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 194:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1564
+//line parser/parser.y:1565
 		{
 			yyVAL.columnType = ColumnType{Type: ""}
 		}
 	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1570
+//line parser/parser.y:1571
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Value: yyDollar[2].optVal}
 		}
 	case 196:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1574
+//line parser/parser.y:1575
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Value: yyDollar[3].optVal}
 		}
 	case 197:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1578
+//line parser/parser.y:1579
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Value: yyDollar[4].optVal}
 		}
 	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1582
+//line parser/parser.y:1583
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Expr: yyDollar[2].expr}
 		}
 	case 199:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1586
+//line parser/parser.y:1587
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Expr: yyDollar[3].expr}
 		}
 	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1592
+//line parser/parser.y:1593
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[1].bytes)
 		}
 	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1596
+//line parser/parser.y:1597
 		{
 			yyVAL.optVal = NewUnicodeStrVal(yyDollar[1].bytes)
 		}
 	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1600
+//line parser/parser.y:1601
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[1].bytes)
 		}
 	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1604
+//line parser/parser.y:1605
 		{
 			yyVAL.optVal = NewFloatVal(yyDollar[1].bytes)
 		}
 	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1608
+//line parser/parser.y:1609
 		{
 			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
 		}
 	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1612
+//line parser/parser.y:1613
 		{
 			yyVAL.optVal = yyDollar[1].optVal
 		}
 	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1616
+//line parser/parser.y:1617
 		{
 			yyVAL.optVal = NewBitVal(yyDollar[1].bytes)
 		}
 	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1620
+//line parser/parser.y:1621
 		{
 			yyVAL.optVal = NewBoolSQLVal(bool(yyDollar[1].boolVal))
 		}
 	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1624
+//line parser/parser.y:1625
 		{
 			yyVAL.optVal = NewBitVal(yyDollar[1].bytes)
 		}
 	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1630
+//line parser/parser.y:1631
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
 	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1636
+//line parser/parser.y:1637
 		{
 			yyVAL.optVal = yyDollar[2].optVal
 		}
 	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1642
+//line parser/parser.y:1643
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[1].bytes)
 		}
 	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1648
+//line parser/parser.y:1649
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1652
+//line parser/parser.y:1653
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
 	case 214:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1657
+//line parser/parser.y:1658
 		{
 			yyVAL.sequence = &Sequence{}
 		}
 	case 215:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1661
+//line parser/parser.y:1662
 		{
 			yyDollar[1].sequence.StartWith = NewIntVal(yyDollar[4].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 216:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1666
+//line parser/parser.y:1667
 		{
 			yyDollar[1].sequence.StartWith = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 217:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1671
+//line parser/parser.y:1672
 		{
 			yyDollar[1].sequence.IncrementBy = NewIntVal(yyDollar[4].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1676
+//line parser/parser.y:1677
 		{
 			yyDollar[1].sequence.IncrementBy = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1681
+//line parser/parser.y:1682
 		{
 			yyDollar[1].sequence.MinValue = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1686
+//line parser/parser.y:1687
 		{
 			yyDollar[1].sequence.MaxValue = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1691
+//line parser/parser.y:1692
 		{
 			yyDollar[1].sequence.Cache = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1696
+//line parser/parser.y:1697
 		{
 			yyDollar[1].sequence.NoMinValue = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1701
+//line parser/parser.y:1702
 		{
 			yyDollar[1].sequence.NoMaxValue = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1706
+//line parser/parser.y:1707
 		{
 			yyDollar[1].sequence.NoCycle = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1711
+//line parser/parser.y:1712
 		{
 			yyDollar[1].sequence.Cycle = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 226:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1716
+//line parser/parser.y:1717
 		{
 			yyDollar[1].sequence.OwnedBy = "NONE"
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 227:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1721
+//line parser/parser.y:1722
 		{
 			yyDollar[1].sequence.OwnedBy = string(yyDollar[4].tableIdent.v) + "." + string(yyDollar[6].colIdent.val)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
 	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1728
+//line parser/parser.y:1729
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, yyDollar[2].optVal)
 		}
 	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1732
+//line parser/parser.y:1733
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
 	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1736
+//line parser/parser.y:1737
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, yyDollar[2].optVal)
 		}
 	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1740
+//line parser/parser.y:1741
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
 	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1744
+//line parser/parser.y:1745
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
 	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1748
+//line parser/parser.y:1749
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
 	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1753
+//line parser/parser.y:1754
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1757
+//line parser/parser.y:1758
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 236:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1762
+//line parser/parser.y:1763
 		{
 			yyVAL.bytes = nil
 		}
 	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1771
+//line parser/parser.y:1772
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.DisplayWidth = yyDollar[2].optVal
 		}
 	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1776
+//line parser/parser.y:1777
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1782
+//line parser/parser.y:1783
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1786
+//line parser/parser.y:1787
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1790
+//line parser/parser.y:1791
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1794
+//line parser/parser.y:1795
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1798
+//line parser/parser.y:1799
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1802
+//line parser/parser.y:1803
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1806
+//line parser/parser.y:1807
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1810
+//line parser/parser.y:1811
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1814
+//line parser/parser.y:1815
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1818
+//line parser/parser.y:1819
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1824
+//line parser/parser.y:1825
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4814,7 +4815,7 @@ yydefault:
 		}
 	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1830
+//line parser/parser.y:1831
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + yyDollar[2].str}
 			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
@@ -4822,7 +4823,7 @@ yydefault:
 		}
 	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1836
+//line parser/parser.y:1837
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4830,7 +4831,7 @@ yydefault:
 		}
 	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1842
+//line parser/parser.y:1843
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4838,7 +4839,7 @@ yydefault:
 		}
 	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1848
+//line parser/parser.y:1849
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -4846,314 +4847,314 @@ yydefault:
 		}
 	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1854
+//line parser/parser.y:1855
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1858
+//line parser/parser.y:1859
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 259:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1863
+//line parser/parser.y:1864
 		{
 			yyVAL.str = ""
 		}
 	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1867
+//line parser/parser.y:1868
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes)
 		}
 	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1873
+//line parser/parser.y:1874
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1877
+//line parser/parser.y:1878
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
 		}
 	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1881
+//line parser/parser.y:1882
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
 		}
 	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1885
+//line parser/parser.y:1886
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1889
+//line parser/parser.y:1890
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1893
+//line parser/parser.y:1894
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1897
+//line parser/parser.y:1898
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1901
+//line parser/parser.y:1902
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1907
+//line parser/parser.y:1908
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1911
+//line parser/parser.y:1912
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1917
+//line parser/parser.y:1918
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 272:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:1921
+//line parser/parser.y:1922
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + yyDollar[2].str, Length: yyDollar[3].optVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1925
+//line parser/parser.y:1926
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1929
+//line parser/parser.y:1930
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1933
+//line parser/parser.y:1934
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1937
+//line parser/parser.y:1938
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1941
+//line parser/parser.y:1942
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1945
+//line parser/parser.y:1946
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1949
+//line parser/parser.y:1950
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1953
+//line parser/parser.y:1954
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1957
+//line parser/parser.y:1958
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1961
+//line parser/parser.y:1962
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1965
+//line parser/parser.y:1966
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1969
+//line parser/parser.y:1970
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1973
+//line parser/parser.y:1974
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1977
+//line parser/parser.y:1978
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1981
+//line parser/parser.y:1982
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1985
+//line parser/parser.y:1986
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1989
+//line parser/parser.y:1990
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1993
+//line parser/parser.y:1994
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 291:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1997
+//line parser/parser.y:1998
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 292:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:2002
+//line parser/parser.y:2003
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 293:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2007
+//line parser/parser.y:2008
 		{
 			yyVAL.str = ""
 		}
 	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2011
+//line parser/parser.y:2012
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes)
 		}
 	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2017
+//line parser/parser.y:2018
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2021
+//line parser/parser.y:2022
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2025
+//line parser/parser.y:2026
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2029
+//line parser/parser.y:2030
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2033
+//line parser/parser.y:2034
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2037
+//line parser/parser.y:2038
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2041
+//line parser/parser.y:2042
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2045
+//line parser/parser.y:2046
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2051
+//line parser/parser.y:2052
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
 	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2056
+//line parser/parser.y:2057
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
 	case 305:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2061
+//line parser/parser.y:2062
 		{
 			yyVAL.optVal = nil
 		}
 	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2065
+//line parser/parser.y:2066
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
 	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2070
+//line parser/parser.y:2071
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 308:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2074
+//line parser/parser.y:2075
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
@@ -5162,13 +5163,13 @@ yydefault:
 		}
 	case 309:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2082
+//line parser/parser.y:2083
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2086
+//line parser/parser.y:2087
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
@@ -5176,7 +5177,7 @@ yydefault:
 		}
 	case 311:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2092
+//line parser/parser.y:2093
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
@@ -5185,19 +5186,19 @@ yydefault:
 		}
 	case 312:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2100
+//line parser/parser.y:2101
 		{
 			yyVAL.optVal = nil
 		}
 	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2104
+//line parser/parser.y:2105
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
 	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2108
+//line parser/parser.y:2109
 		{
 			if strings.ToLower(string(yyDollar[2].bytes)) != "max" {
 				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
@@ -5206,375 +5207,375 @@ yydefault:
 		}
 	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2117
+//line parser/parser.y:2118
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2121
+//line parser/parser.y:2122
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2125
+//line parser/parser.y:2126
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 318:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2130
+//line parser/parser.y:2131
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2134
+//line parser/parser.y:2135
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2139
+//line parser/parser.y:2140
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2143
+//line parser/parser.y:2144
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2148
+//line parser/parser.y:2149
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2152
+//line parser/parser.y:2153
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2156
+//line parser/parser.y:2157
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2161
+//line parser/parser.y:2162
 		{
 			yyVAL.str = ""
 		}
 	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2165
+//line parser/parser.y:2166
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
 	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2169
+//line parser/parser.y:2170
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
 	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2174
+//line parser/parser.y:2175
 		{
 			yyVAL.str = ""
 		}
 	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2178
+//line parser/parser.y:2179
 		{
 			yyVAL.str = string(yyDollar[1].bytes) // Set pseudo collation "binary" for BINARY attribute (deprecated in future MySQL versions)
 		}
 	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2182
+//line parser/parser.y:2183
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
 	case 331:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:2188
+//line parser/parser.y:2189
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions, Partition: yyDollar[6].indexPartition}
 		}
 	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2193
+//line parser/parser.y:2194
 		{
 			yyVAL.indexOptions = []*IndexOption{}
 		}
 	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2197
+//line parser/parser.y:2198
 		{
 			yyVAL.indexOptions = yyDollar[1].indexOptions
 		}
 	case 334:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2201
+//line parser/parser.y:2202
 		{
 			yyVAL.indexOptions = yyDollar[3].indexOptions
 		}
 	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2207
+//line parser/parser.y:2208
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
 	case 336:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2211
+//line parser/parser.y:2212
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
 	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2217
+//line parser/parser.y:2218
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
 	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2221
+//line parser/parser.y:2222
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[3].indexOption)
 		}
 	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2227
+//line parser/parser.y:2228
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
 	case 340:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2231
+//line parser/parser.y:2232
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
 	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2236
+//line parser/parser.y:2237
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
 	case 342:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2240
+//line parser/parser.y:2241
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[2].bytes), Value: NewStrVal([]byte(yyDollar[3].colIdent.String()))}
 		}
 	case 343:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2244
+//line parser/parser.y:2245
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
 	case 344:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2248
+//line parser/parser.y:2249
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
 	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2252
+//line parser/parser.y:2253
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
 	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2256
+//line parser/parser.y:2257
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
 	case 347:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2260
+//line parser/parser.y:2261
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
 	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2264
+//line parser/parser.y:2265
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
 	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2268
+//line parser/parser.y:2269
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
 	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2274
+//line parser/parser.y:2275
 		{
 			yyVAL.str = ""
 		}
 	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2278
+//line parser/parser.y:2279
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2284
+//line parser/parser.y:2285
 		{
 			yyVAL.optVal = NewBoolSQLVal(true)
 		}
 	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2288
+//line parser/parser.y:2289
 		{
 			yyVAL.optVal = NewBoolSQLVal(false)
 		}
 	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2294
+//line parser/parser.y:2295
 		{
 			yyVAL.indexPartition = nil
 		}
 	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2298
+//line parser/parser.y:2299
 		{
 			yyVAL.indexPartition = &IndexPartition{Name: yyDollar[2].colIdent.String()}
 		}
 	case 356:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2302
+//line parser/parser.y:2303
 		{
 			yyVAL.indexPartition = &IndexPartition{Name: yyDollar[2].colIdent.String(), Column: yyDollar[4].colIdent.String()}
 		}
 	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2308
+//line parser/parser.y:2309
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 	case 358:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2312
+//line parser/parser.y:2313
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
 		}
 	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2316
+//line parser/parser.y:2317
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Fulltext: true}
 		}
 	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2320
+//line parser/parser.y:2321
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Fulltext: true}
 		}
 	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2324
+//line parser/parser.y:2325
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
 		}
 	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2328
+//line parser/parser.y:2329
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
 	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2332
+//line parser/parser.y:2333
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(""), Unique: true}
 		}
 	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2336
+//line parser/parser.y:2337
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false, Clustered: yyDollar[3].boolVal}
 		}
 	case 365:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2340
+//line parser/parser.y:2341
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true, Clustered: yyDollar[4].boolVal}
 		}
 	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2346
+//line parser/parser.y:2347
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2350
+//line parser/parser.y:2351
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2356
+//line parser/parser.y:2357
 		{
 			yyVAL.indexColumnsOrExpression = IndexColumnsOrExpression{IndexCols: yyDollar[1].indexColumns}
 		}
 	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2361
+//line parser/parser.y:2362
 		{
 			yyVAL.indexColumnsOrExpression = IndexColumnsOrExpression{IndexExpr: yyDollar[1].expr}
 		}
 	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2367
+//line parser/parser.y:2368
 		{
 			yyVAL.indexColumns = []IndexColumn{yyDollar[1].indexColumn}
 		}
 	case 371:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2371
+//line parser/parser.y:2372
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
 	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2377
+//line parser/parser.y:2378
 		{
 			yyVAL.indexColumn = IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal, Direction: yyDollar[3].str}
 		}
 	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2382
+//line parser/parser.y:2383
 		{
 			yyVAL.indexColumn = IndexColumn{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].optVal}
 		}
 	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2386
+//line parser/parser.y:2387
 		{
 			yyVAL.indexColumn = IndexColumn{Column: yyDollar[1].colIdent, OperatorClass: string(yyDollar[2].bytes)}
 		}
 	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2396
+//line parser/parser.y:2397
 		{
 			yyDollar[1].foreignKeyDefinition.NotForReplication = bool(yyDollar[2].boolVal)
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
 	case 377:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2401
+//line parser/parser.y:2402
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = NewColIdent("")
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[4].colIdent
@@ -5583,7 +5584,7 @@ yydefault:
 		}
 	case 378:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2408
+//line parser/parser.y:2409
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[4].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = NewColIdent("")
@@ -5592,7 +5593,7 @@ yydefault:
 		}
 	case 379:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:2415
+//line parser/parser.y:2416
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[7].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[4].colIdent
@@ -5601,7 +5602,7 @@ yydefault:
 		}
 	case 380:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:2422
+//line parser/parser.y:2423
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[4].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[7].colIdent
@@ -5610,7 +5611,7 @@ yydefault:
 		}
 	case 381:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:2431
+//line parser/parser.y:2432
 		{
 			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{
 				ConstraintName:   yyDollar[2].colIdent,
@@ -5622,7 +5623,7 @@ yydefault:
 		}
 	case 382:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser/parser.y:2442
+//line parser/parser.y:2443
 		{
 			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{
 				IndexName:        yyDollar[3].colIdent,
@@ -5633,31 +5634,31 @@ yydefault:
 		}
 	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2453
+//line parser/parser.y:2454
 		{
 			yyVAL.colIdent = NewColIdent("RESTRICT")
 		}
 	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2457
+//line parser/parser.y:2458
 		{
 			yyVAL.colIdent = NewColIdent("CASCADE")
 		}
 	case 385:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2461
+//line parser/parser.y:2462
 		{
 			yyVAL.colIdent = NewColIdent("SET NULL")
 		}
 	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2465
+//line parser/parser.y:2466
 		{
 			yyVAL.colIdent = NewColIdent("NO ACTION")
 		}
 	case 387:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser/parser.y:2471
+//line parser/parser.y:2472
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:      &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: yyDollar[2].colIdent, Primary: true, Unique: true, Clustered: yyDollar[5].boolVal},
@@ -5668,7 +5669,7 @@ yydefault:
 		}
 	case 388:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:2481
+//line parser/parser.y:2482
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:      &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Primary: true, Unique: true, Clustered: yyDollar[3].boolVal},
@@ -5679,7 +5680,7 @@ yydefault:
 		}
 	case 389:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:2492
+//line parser/parser.y:2493
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:      &IndexInfo{Type: string(yyDollar[3].bytes), Name: yyDollar[2].colIdent, Primary: false, Unique: true, Clustered: yyDollar[4].boolVal},
@@ -5690,7 +5691,7 @@ yydefault:
 		}
 	case 390:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:2502
+//line parser/parser.y:2503
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:      &IndexInfo{Type: string(yyDollar[1].bytes), Primary: false, Unique: true, Clustered: yyDollar[2].boolVal},
@@ -5701,7 +5702,7 @@ yydefault:
 		}
 	case 391:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:2513
+//line parser/parser.y:2514
 		{
 			yyVAL.checkDefinition = &CheckDefinition{
 				ConstraintName: yyDollar[2].colIdent,
@@ -5711,7 +5712,7 @@ yydefault:
 		}
 	case 392:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2522
+//line parser/parser.y:2523
 		{
 			yyVAL.checkDefinition = &CheckDefinition{
 				Where:     *NewWhere(WhereStr, yyDollar[3].expr),
@@ -5720,653 +5721,653 @@ yydefault:
 		}
 	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2531
+//line parser/parser.y:2532
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2535
+//line parser/parser.y:2536
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2539
+//line parser/parser.y:2540
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2545
+//line parser/parser.y:2546
 		{
 			yyVAL.boolVals = []BoolVal{false, false}
 		}
 	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2549
+//line parser/parser.y:2550
 		{
 			yyVAL.boolVals = []BoolVal{false, true}
 		}
 	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2553
+//line parser/parser.y:2554
 		{
 			yyVAL.boolVals = []BoolVal{false, false}
 		}
 	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2557
+//line parser/parser.y:2558
 		{
 			yyVAL.boolVals = []BoolVal{true, false}
 		}
 	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2561
+//line parser/parser.y:2562
 		{
 			yyVAL.boolVals = []BoolVal{true, true}
 		}
 	case 401:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2565
+//line parser/parser.y:2566
 		{
 			yyVAL.boolVals = []BoolVal{true, false}
 		}
 	case 402:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2571
+//line parser/parser.y:2572
 		{
 		}
 	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2572
+//line parser/parser.y:2573
 		{
 		}
 	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2576
+//line parser/parser.y:2577
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2580
+//line parser/parser.y:2581
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 406:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2585
+//line parser/parser.y:2586
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
 	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2592
+//line parser/parser.y:2593
 		{
 			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
 		}
 	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2596
+//line parser/parser.y:2597
 		{
 			yyVAL.colIdents = append(yyDollar[1].colIdents, yyDollar[3].colIdent)
 		}
 	case 410:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2603
+//line parser/parser.y:2604
 		{
 			yyVAL.tableOptions = map[string]string{}
 		}
 	case 411:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2607
+//line parser/parser.y:2608
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
 			yyVAL.tableOptions[string(yyDollar[2].str)] = string(yyDollar[4].str)
 		}
 	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2613
+//line parser/parser.y:2614
 		{
 			yyVAL.tableOptions = map[string]string{}
 		}
 	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2617
+//line parser/parser.y:2618
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
 		}
 	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2622
+//line parser/parser.y:2623
 		{
 		}
 	case 415:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2623
+//line parser/parser.y:2624
 		{
 		}
 	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2627
+//line parser/parser.y:2628
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2631
+//line parser/parser.y:2632
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].colIdent.String()
 		}
 	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2635
+//line parser/parser.y:2636
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2641
+//line parser/parser.y:2642
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2645
+//line parser/parser.y:2646
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
 	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2649
+//line parser/parser.y:2650
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 422:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2654
+//line parser/parser.y:2655
 		{
 			setAllowComments(yylex, true)
 		}
 	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2658
+//line parser/parser.y:2659
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
 	case 424:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2664
+//line parser/parser.y:2665
 		{
 			yyVAL.bytes2 = nil
 		}
 	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2668
+//line parser/parser.y:2669
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
 	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2674
+//line parser/parser.y:2675
 		{
 			yyVAL.str = UnionStr
 		}
 	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2678
+//line parser/parser.y:2679
 		{
 			yyVAL.str = UnionAllStr
 		}
 	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2682
+//line parser/parser.y:2683
 		{
 			yyVAL.str = UnionDistinctStr
 		}
 	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2687
+//line parser/parser.y:2688
 		{
 			yyVAL.str = ""
 		}
 	case 430:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2691
+//line parser/parser.y:2692
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
 	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2695
+//line parser/parser.y:2696
 		{
 			yyVAL.str = SQLCacheStr
 		}
 	case 432:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2700
+//line parser/parser.y:2701
 		{
 			yyVAL.str = ""
 		}
 	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2704
+//line parser/parser.y:2705
 		{
 			yyVAL.str = DistinctStr
 		}
 	case 434:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2709
+//line parser/parser.y:2710
 		{
 			yyVAL.str = ""
 		}
 	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2713
+//line parser/parser.y:2714
 		{
 			yyVAL.str = StraightJoinHint
 		}
 	case 436:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2718
+//line parser/parser.y:2719
 		{
 			yyVAL.selectExprs = nil
 		}
 	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2722
+//line parser/parser.y:2723
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
 	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2728
+//line parser/parser.y:2729
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
 	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2732
+//line parser/parser.y:2733
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
 	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2738
+//line parser/parser.y:2739
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
 	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2742
+//line parser/parser.y:2743
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
 	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2746
+//line parser/parser.y:2747
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2750
+//line parser/parser.y:2751
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Schema: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2755
+//line parser/parser.y:2756
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
 	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2759
+//line parser/parser.y:2760
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 446:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2763
+//line parser/parser.y:2764
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2770
+//line parser/parser.y:2771
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
 	case 449:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2775
+//line parser/parser.y:2776
 		{
 			yyVAL.overExpr = nil
 		}
 	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2779
+//line parser/parser.y:2780
 		{
 			yyVAL.overExpr = &OverExpr{}
 		}
 	case 451:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:2783
+//line parser/parser.y:2784
 		{
 			yyVAL.overExpr = &OverExpr{PartitionBy: yyDollar[5].partitionBy}
 		}
 	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2787
+//line parser/parser.y:2788
 		{
 			yyVAL.overExpr = &OverExpr{OrderBy: yyDollar[3].orderBy}
 		}
 	case 453:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:2791
+//line parser/parser.y:2792
 		{
 			yyVAL.overExpr = &OverExpr{PartitionBy: yyDollar[5].partitionBy, OrderBy: yyDollar[6].orderBy}
 		}
 	case 454:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2796
+//line parser/parser.y:2797
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2800
+//line parser/parser.y:2801
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
 	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2806
+//line parser/parser.y:2807
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
 	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2810
+//line parser/parser.y:2811
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
 	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2820
+//line parser/parser.y:2821
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
 	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2824
+//line parser/parser.y:2825
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
 	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2828
+//line parser/parser.y:2829
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
 	case 463:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2833
+//line parser/parser.y:2834
 		{
 			yyVAL.strs = []string{}
 		}
 	case 464:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2837
+//line parser/parser.y:2838
 		{
 			yyVAL.strs = yyDollar[3].strs
 		}
 	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2843
+//line parser/parser.y:2844
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
 	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2847
+//line parser/parser.y:2848
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
 	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2853
+//line parser/parser.y:2854
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2857
+//line parser/parser.y:2858
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2861
+//line parser/parser.y:2862
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2865
+//line parser/parser.y:2866
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2869
+//line parser/parser.y:2870
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2873
+//line parser/parser.y:2874
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2879
+//line parser/parser.y:2880
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, IndexHints: yyDollar[3].indexHints, TableHints: yyDollar[4].strs}
 		}
 	case 474:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:2883
+//line parser/parser.y:2884
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, IndexHints: yyDollar[7].indexHints, TableHints: yyDollar[8].strs}
 		}
 	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2889
+//line parser/parser.y:2890
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
 	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2894
+//line parser/parser.y:2895
 		{
 			yyVAL.columns = Columns{NewColIdent(string(yyDollar[1].bytes))}
 		}
 	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2898
+//line parser/parser.y:2899
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
 	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2904
+//line parser/parser.y:2905
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
 	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2908
+//line parser/parser.y:2909
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
 	case 480:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2921
+//line parser/parser.y:2922
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
 	case 481:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2925
+//line parser/parser.y:2926
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
 	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2929
+//line parser/parser.y:2930
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
 	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2933
+//line parser/parser.y:2934
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
 	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2939
+//line parser/parser.y:2940
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
 	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2941
+//line parser/parser.y:2942
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
 	case 486:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2945
+//line parser/parser.y:2946
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
 	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2947
+//line parser/parser.y:2948
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
 	case 488:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2951
+//line parser/parser.y:2952
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
 	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2953
+//line parser/parser.y:2954
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
 	case 490:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2956
+//line parser/parser.y:2957
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2958
+//line parser/parser.y:2959
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2961
+//line parser/parser.y:2962
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
 	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2965
+//line parser/parser.y:2966
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
 	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2969
+//line parser/parser.y:2970
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
 	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2976
+//line parser/parser.y:2977
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
 	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2982
+//line parser/parser.y:2983
 		{
 			yyVAL.str = JoinStr
 		}
 	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2986
+//line parser/parser.y:2987
 		{
 			yyVAL.str = JoinStr
 		}
 	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2990
+//line parser/parser.y:2991
 		{
 			yyVAL.str = JoinStr
 		}
 	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2996
+//line parser/parser.y:2997
 		{
 			yyVAL.str = StraightJoinStr
 		}
 	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3002
+//line parser/parser.y:3003
 		{
 			yyVAL.str = LeftJoinStr
 		}
 	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3006
+//line parser/parser.y:3007
 		{
 			yyVAL.str = LeftJoinStr
 		}
 	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3010
+//line parser/parser.y:3011
 		{
 			yyVAL.str = RightJoinStr
 		}
 	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3014
+//line parser/parser.y:3015
 		{
 			yyVAL.str = RightJoinStr
 		}
 	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3020
+//line parser/parser.y:3021
 		{
 			yyVAL.str = NaturalJoinStr
 		}
 	case 506:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3024
+//line parser/parser.y:3025
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -6376,517 +6377,517 @@ yydefault:
 		}
 	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3034
+//line parser/parser.y:3035
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
 	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3038
+//line parser/parser.y:3039
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
 	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3044
+//line parser/parser.y:3045
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
 	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3048
+//line parser/parser.y:3049
 		{
 			yyVAL.tableName = TableName{Schema: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
 	case 511:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3053
+//line parser/parser.y:3054
 		{
 			yyVAL.indexHints = nil
 		}
 	case 512:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3057
+//line parser/parser.y:3058
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
 	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3061
+//line parser/parser.y:3062
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
 	case 514:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3065
+//line parser/parser.y:3066
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
 	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3070
+//line parser/parser.y:3071
 		{
 			yyVAL.expr = nil
 		}
 	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3074
+//line parser/parser.y:3075
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
 	case 517:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3079
+//line parser/parser.y:3080
 		{
 			yyVAL.columns = nil
 		}
 	case 518:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3083
+//line parser/parser.y:3084
 		{
 			yyVAL.columns = yyDollar[3].columns
 		}
 	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3089
+//line parser/parser.y:3090
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
 	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3093
+//line parser/parser.y:3094
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
 	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3097
+//line parser/parser.y:3098
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
 	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3101
+//line parser/parser.y:3102
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
 	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3105
+//line parser/parser.y:3106
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
 	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3109
+//line parser/parser.y:3110
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
 	case 525:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3113
+//line parser/parser.y:3114
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
 	case 526:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3119
+//line parser/parser.y:3120
 		{
 			yyVAL.str = ""
 		}
 	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3123
+//line parser/parser.y:3124
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
 	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3129
+//line parser/parser.y:3130
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3133
+//line parser/parser.y:3134
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3139
+//line parser/parser.y:3140
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
 	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3143
+//line parser/parser.y:3144
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[4].expr, All: true}
 		}
 	case 532:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3147
+//line parser/parser.y:3148
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[4].expr, Any: true}
 		}
 	case 533:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3151
+//line parser/parser.y:3152
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[4].expr, Any: true}
 		}
 	case 534:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3155
+//line parser/parser.y:3156
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
 	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3159
+//line parser/parser.y:3160
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
 	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3163
+//line parser/parser.y:3164
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
 	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3167
+//line parser/parser.y:3168
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
 	case 538:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3171
+//line parser/parser.y:3172
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
 	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3175
+//line parser/parser.y:3176
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
 	case 540:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3179
+//line parser/parser.y:3180
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
 	case 541:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3183
+//line parser/parser.y:3184
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
 	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3187
+//line parser/parser.y:3188
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
 	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3193
+//line parser/parser.y:3194
 		{
 			yyVAL.str = IsNullStr
 		}
 	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3197
+//line parser/parser.y:3198
 		{
 			yyVAL.str = IsNotNullStr
 		}
 	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3201
+//line parser/parser.y:3202
 		{
 			yyVAL.str = IsTrueStr
 		}
 	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3205
+//line parser/parser.y:3206
 		{
 			yyVAL.str = IsNotTrueStr
 		}
 	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3209
+//line parser/parser.y:3210
 		{
 			yyVAL.str = IsFalseStr
 		}
 	case 548:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3213
+//line parser/parser.y:3214
 		{
 			yyVAL.str = IsNotFalseStr
 		}
 	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3219
+//line parser/parser.y:3220
 		{
 			yyVAL.str = EqualStr
 		}
 	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3223
+//line parser/parser.y:3224
 		{
 			yyVAL.str = LessThanStr
 		}
 	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3227
+//line parser/parser.y:3228
 		{
 			yyVAL.str = GreaterThanStr
 		}
 	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3231
+//line parser/parser.y:3232
 		{
 			yyVAL.str = LessEqualStr
 		}
 	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3235
+//line parser/parser.y:3236
 		{
 			yyVAL.str = GreaterEqualStr
 		}
 	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3239
+//line parser/parser.y:3240
 		{
 			yyVAL.str = NotEqualStr
 		}
 	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3243
+//line parser/parser.y:3244
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
 	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3247
+//line parser/parser.y:3248
 		{
 			yyVAL.str = PosixRegexStr
 		}
 	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3251
+//line parser/parser.y:3252
 		{
 			yyVAL.str = PosixRegexCiStr
 		}
 	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3255
+//line parser/parser.y:3256
 		{
 			yyVAL.str = PosixNotRegexStr
 		}
 	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3259
+//line parser/parser.y:3260
 		{
 			yyVAL.str = PosixNotRegexCiStr
 		}
 	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3264
+//line parser/parser.y:3265
 		{
 			yyVAL.expr = nil
 		}
 	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3268
+//line parser/parser.y:3269
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
 	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3274
+//line parser/parser.y:3275
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
 	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3278
+//line parser/parser.y:3279
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
 	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3282
+//line parser/parser.y:3283
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
 	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3288
+//line parser/parser.y:3289
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
 	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3294
+//line parser/parser.y:3295
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
 	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3298
+//line parser/parser.y:3299
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
 	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3304
+//line parser/parser.y:3305
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
 	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3308
+//line parser/parser.y:3309
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
 	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3312
+//line parser/parser.y:3313
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
 	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3316
+//line parser/parser.y:3317
 		{
 			yyVAL.expr = yyDollar[1].newQualifierColName
 		}
 	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3320
+//line parser/parser.y:3321
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
 	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3324
+//line parser/parser.y:3325
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
 	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3328
+//line parser/parser.y:3329
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
 	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3332
+//line parser/parser.y:3333
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
 	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3336
+//line parser/parser.y:3337
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
 	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3340
+//line parser/parser.y:3341
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
 	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3344
+//line parser/parser.y:3345
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
 	case 579:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3348
+//line parser/parser.y:3349
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
 	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3352
+//line parser/parser.y:3353
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
 	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3356
+//line parser/parser.y:3357
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
 	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3360
+//line parser/parser.y:3361
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
 	case 583:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3364
+//line parser/parser.y:3365
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
 	case 584:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3368
+//line parser/parser.y:3369
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
 	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3372
+//line parser/parser.y:3373
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
 	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3376
+//line parser/parser.y:3377
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
 	case 587:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3380
+//line parser/parser.y:3381
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
 	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3384
+//line parser/parser.y:3385
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr}
 		}
 	case 589:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3388
+//line parser/parser.y:3389
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr}
 		}
 	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3392
+//line parser/parser.y:3393
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
 	case 591:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3396
+//line parser/parser.y:3397
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
 	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3400
+//line parser/parser.y:3401
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6896,7 +6897,7 @@ yydefault:
 		}
 	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3408
+//line parser/parser.y:3409
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6912,19 +6913,19 @@ yydefault:
 		}
 	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3422
+//line parser/parser.y:3423
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
 	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3426
+//line parser/parser.y:3427
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
 	case 596:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3430
+//line parser/parser.y:3431
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6934,7 +6935,7 @@ yydefault:
 		}
 	case 597:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3438
+//line parser/parser.y:3439
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6944,349 +6945,349 @@ yydefault:
 		}
 	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3446
+//line parser/parser.y:3447
 		{
 			yyVAL.expr = &CastExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
 		}
 	case 603:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3454
+//line parser/parser.y:3455
 		{
 			yyVAL.expr = yyDollar[2].arrayConstructor
 		}
 	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3458
+//line parser/parser.y:3459
 		{
 			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
 	case 605:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3468
+//line parser/parser.y:3469
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
 	case 606:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3472
+//line parser/parser.y:3473
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
 	case 607:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3476
+//line parser/parser.y:3477
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overExpr}
 		}
 	case 608:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3480
+//line parser/parser.y:3481
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overExpr}
 		}
 	case 609:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3484
+//line parser/parser.y:3485
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overExpr}
 		}
 	case 610:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3488
+//line parser/parser.y:3489
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
 	case 611:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3492
+//line parser/parser.y:3493
 		{
 			yyVAL.expr = &FuncCallExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].exprs}
 		}
 	case 612:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3502
+//line parser/parser.y:3503
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3506
+//line parser/parser.y:3507
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 614:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3510
+//line parser/parser.y:3511
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
 	case 615:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3514
+//line parser/parser.y:3515
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[5].expr, Type: yyDollar[3].convertType}
 		}
 	case 616:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3518
+//line parser/parser.y:3519
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
 	case 617:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3522
+//line parser/parser.y:3523
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
 	case 618:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3526
+//line parser/parser.y:3527
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: nil}
 		}
 	case 619:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:3530
+//line parser/parser.y:3531
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
 	case 620:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3534
+//line parser/parser.y:3535
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: nil}
 		}
 	case 621:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:3538
+//line parser/parser.y:3539
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
 	case 622:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3542
+//line parser/parser.y:3543
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: nil}
 		}
 	case 623:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:3546
+//line parser/parser.y:3547
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
 	case 624:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3550
+//line parser/parser.y:3551
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: nil}
 		}
 	case 625:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:3554
+//line parser/parser.y:3555
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
 	case 626:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:3558
+//line parser/parser.y:3559
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
 	case 627:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:3562
+//line parser/parser.y:3563
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
 	case 628:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3566
+//line parser/parser.y:3567
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
 	case 629:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3570
+//line parser/parser.y:3571
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
 	case 630:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3575
+//line parser/parser.y:3576
 		{
 			yyVAL.expr = &NextSeqValExpr{SequenceName: yyDollar[4].tableIdent}
 		}
 	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3579
+//line parser/parser.y:3580
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
 	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3583
+//line parser/parser.y:3584
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
 	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3587
+//line parser/parser.y:3588
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
 	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3597
+//line parser/parser.y:3598
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3601
+//line parser/parser.y:3602
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3605
+//line parser/parser.y:3606
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3609
+//line parser/parser.y:3610
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3614
+//line parser/parser.y:3615
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3619
+//line parser/parser.y:3620
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3624
+//line parser/parser.y:3625
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3629
+//line parser/parser.y:3630
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 	case 642:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3633
+//line parser/parser.y:3634
 		{
 			yyVAL.expr = &ConvertExpr{Type: yyDollar[2].convertType}
 		}
 	case 645:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3647
+//line parser/parser.y:3648
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3651
+//line parser/parser.y:3652
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 647:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3655
+//line parser/parser.y:3656
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 648:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3659
+//line parser/parser.y:3660
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
 	case 649:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3665
+//line parser/parser.y:3666
 		{
 			yyVAL.str = ""
 		}
 	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3669
+//line parser/parser.y:3670
 		{
 			yyVAL.str = BooleanModeStr
 		}
 	case 651:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3673
+//line parser/parser.y:3674
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
 	case 652:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:3677
+//line parser/parser.y:3678
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
 	case 653:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3681
+//line parser/parser.y:3682
 		{
 			yyVAL.str = QueryExpansionStr
 		}
 	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3687
+//line parser/parser.y:3688
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3691
+//line parser/parser.y:3692
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
 	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3697
+//line parser/parser.y:3698
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3701
+//line parser/parser.y:3702
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
 	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3705
+//line parser/parser.y:3706
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
 	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3709
+//line parser/parser.y:3710
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 660:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3713
+//line parser/parser.y:3714
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3717
+//line parser/parser.y:3718
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7294,598 +7295,598 @@ yydefault:
 		}
 	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3723
+//line parser/parser.y:3724
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3727
+//line parser/parser.y:3728
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3731
+//line parser/parser.y:3732
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 665:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3735
+//line parser/parser.y:3736
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 666:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3739
+//line parser/parser.y:3740
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3743
+//line parser/parser.y:3744
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3747
+//line parser/parser.y:3748
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3751
+//line parser/parser.y:3752
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3755
+//line parser/parser.y:3756
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3759
+//line parser/parser.y:3760
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3763
+//line parser/parser.y:3764
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3767
+//line parser/parser.y:3768
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].LengthScaleOption.Length, Scale: yyDollar[2].LengthScaleOption.Scale}
 		}
 	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3771
+//line parser/parser.y:3772
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3775
+//line parser/parser.y:3776
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3779
+//line parser/parser.y:3780
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3783
+//line parser/parser.y:3784
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3787
+//line parser/parser.y:3788
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3791
+//line parser/parser.y:3792
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3795
+//line parser/parser.y:3796
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3799
+//line parser/parser.y:3800
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3803
+//line parser/parser.y:3804
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3807
+//line parser/parser.y:3808
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3811
+//line parser/parser.y:3812
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3815
+//line parser/parser.y:3816
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3819
+//line parser/parser.y:3820
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 687:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3823
+//line parser/parser.y:3824
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3829
+//line parser/parser.y:3830
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3833
+//line parser/parser.y:3834
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
 		}
 	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3837
+//line parser/parser.y:3838
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3841
+//line parser/parser.y:3842
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3845
+//line parser/parser.y:3846
 		{
 			yyVAL.convertType = &ConvertType{Type: yyDollar[1].columnType.Type}
 		}
 	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3849
+//line parser/parser.y:3850
 		{
 			yyVAL.convertType = &ConvertType{Type: yyDollar[1].columnType.Type}
 		}
 	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3853
+//line parser/parser.y:3854
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3857
+//line parser/parser.y:3858
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
 	case 696:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3862
+//line parser/parser.y:3863
 		{
 			yyVAL.expr = nil
 		}
 	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3866
+//line parser/parser.y:3867
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
 	case 698:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3871
+//line parser/parser.y:3872
 		{
 			yyVAL.str = string("")
 		}
 	case 699:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3875
+//line parser/parser.y:3876
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
 	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3881
+//line parser/parser.y:3882
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
 	case 701:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3885
+//line parser/parser.y:3886
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
 	case 702:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3891
+//line parser/parser.y:3892
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
 	case 703:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3896
+//line parser/parser.y:3897
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 704:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3898
+//line parser/parser.y:3899
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 705:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3901
+//line parser/parser.y:3902
 		{
 			yyVAL.expr = nil
 		}
 	case 706:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3905
+//line parser/parser.y:3906
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
 	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3911
+//line parser/parser.y:3912
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
 	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3915
+//line parser/parser.y:3916
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 	case 709:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3919
+//line parser/parser.y:3920
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Schema: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3925
+//line parser/parser.y:3926
 		{
 			yyVAL.newQualifierColName = &NewQualifierColName{Name: yyDollar[3].colIdent}
 		}
 	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3931
+//line parser/parser.y:3932
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
 	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3935
+//line parser/parser.y:3936
 		{
 			yyVAL.expr = NewUnicodeStrVal(yyDollar[1].bytes)
 		}
 	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3940
+//line parser/parser.y:3941
 		{
 			// Ignoring _charset_name as a workaround
 			yyVAL.expr = NewStrVal(yyDollar[2].bytes)
 		}
 	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3945
+//line parser/parser.y:3946
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
 	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3949
+//line parser/parser.y:3950
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
 	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3953
+//line parser/parser.y:3954
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
 	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3957
+//line parser/parser.y:3958
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
 	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3961
+//line parser/parser.y:3962
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
 	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3965
+//line parser/parser.y:3966
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
 	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3969
+//line parser/parser.y:3970
 		{
 			yyVAL.expr = &NullVal{}
 		}
 	case 721:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3974
+//line parser/parser.y:3975
 		{
 			yyVAL.exprs = nil
 		}
 	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3978
+//line parser/parser.y:3979
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
 	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3983
+//line parser/parser.y:3984
 		{
 			yyVAL.expr = nil
 		}
 	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3987
+//line parser/parser.y:3988
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
 	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3993
+//line parser/parser.y:3994
 		{
 			yyVAL.partitionBy = PartitionBy{yyDollar[1].partition}
 		}
 	case 726:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3997
+//line parser/parser.y:3998
 		{
 			yyVAL.partitionBy = append(yyDollar[1].partitionBy, yyDollar[3].partition)
 		}
 	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4003
+//line parser/parser.y:4004
 		{
 			yyVAL.partition = &Partition{Expr: yyDollar[1].expr}
 		}
 	case 728:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4008
+//line parser/parser.y:4009
 		{
 			yyVAL.orderBy = nil
 		}
 	case 729:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4012
+//line parser/parser.y:4013
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
 	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4018
+//line parser/parser.y:4019
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
 	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4022
+//line parser/parser.y:4023
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
 	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4028
+//line parser/parser.y:4029
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
 	case 733:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4033
+//line parser/parser.y:4034
 		{
 			yyVAL.str = AscScr
 		}
 	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4037
+//line parser/parser.y:4038
 		{
 			yyVAL.str = AscScr
 		}
 	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4041
+//line parser/parser.y:4042
 		{
 			yyVAL.str = DescScr
 		}
 	case 736:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4046
+//line parser/parser.y:4047
 		{
 			yyVAL.limit = nil
 		}
 	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4050
+//line parser/parser.y:4051
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
 	case 738:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4054
+//line parser/parser.y:4055
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
 	case 739:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4058
+//line parser/parser.y:4059
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
 	case 740:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4063
+//line parser/parser.y:4064
 		{
 			yyVAL.str = ""
 		}
 	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4067
+//line parser/parser.y:4068
 		{
 			yyVAL.str = ForUpdateStr
 		}
 	case 742:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4071
+//line parser/parser.y:4072
 		{
 			yyVAL.str = ShareModeStr
 		}
 	case 743:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4084
+//line parser/parser.y:4085
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
 	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4088
+//line parser/parser.y:4089
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
 	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4092
+//line parser/parser.y:4093
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
 	case 746:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:4097
+//line parser/parser.y:4098
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
 	case 747:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4101
+//line parser/parser.y:4102
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
 	case 748:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:4105
+//line parser/parser.y:4106
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
 	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4112
+//line parser/parser.y:4113
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
 	case 750:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4116
+//line parser/parser.y:4117
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
 	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4120
+//line parser/parser.y:4121
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
 	case 752:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:4124
+//line parser/parser.y:4125
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
 	case 753:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4129
+//line parser/parser.y:4130
 		{
 			yyVAL.updateExprs = nil
 		}
 	case 754:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:4133
+//line parser/parser.y:4134
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
 	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4139
+//line parser/parser.y:4140
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
 	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4143
+//line parser/parser.y:4144
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
 	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4149
+//line parser/parser.y:4150
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
 	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4153
+//line parser/parser.y:4154
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
 	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4159
+//line parser/parser.y:4160
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
 	case 760:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4165
+//line parser/parser.y:4166
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7895,253 +7896,253 @@ yydefault:
 		}
 	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4175
+//line parser/parser.y:4176
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
 	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4179
+//line parser/parser.y:4180
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
 	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4185
+//line parser/parser.y:4186
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
 	case 764:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4191
+//line parser/parser.y:4192
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
 	case 765:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4195
+//line parser/parser.y:4196
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
 	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4201
+//line parser/parser.y:4202
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
 	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4205
+//line parser/parser.y:4206
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
 	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4209
+//line parser/parser.y:4210
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
 	case 769:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:4214
+//line parser/parser.y:4215
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("NEW." + yyDollar[3].colIdent.val), Expr: yyDollar[5].expr}
 		}
 	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4218
+//line parser/parser.y:4219
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
 	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4224
+//line parser/parser.y:4225
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
 	case 772:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4230
+//line parser/parser.y:4231
 		{
 			yyVAL.statement = &SetBoolOption{OptionNames: yyDollar[2].strs, Value: yyDollar[3].optVal}
 		}
 	case 774:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4237
+//line parser/parser.y:4238
 		{
 			yyVAL.bytes = []byte("charset")
 		}
 	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4244
+//line parser/parser.y:4245
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
 	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4248
+//line parser/parser.y:4249
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
 	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4252
+//line parser/parser.y:4253
 		{
 			yyVAL.expr = &Default{}
 		}
 	case 779:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4257
+//line parser/parser.y:4258
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 780:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4259
+//line parser/parser.y:4260
 		{
 			yyVAL.empty = struct{}{}
 		}
 	case 781:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4262
+//line parser/parser.y:4263
 		{
 			yyVAL.str = ""
 		}
 	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4264
+//line parser/parser.y:4265
 		{
 			yyVAL.str = IgnoreStr
 		}
 	case 783:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4268
+//line parser/parser.y:4269
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
 	case 785:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4275
+//line parser/parser.y:4276
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
 	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4279
+//line parser/parser.y:4280
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
 	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4283
+//line parser/parser.y:4284
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
 	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4289
+//line parser/parser.y:4290
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
 	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4294
+//line parser/parser.y:4295
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
 	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4301
+//line parser/parser.y:4302
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
 	case 792:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4307
+//line parser/parser.y:4308
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 793:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4311
+//line parser/parser.y:4312
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4315
+//line parser/parser.y:4316
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 795:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4321
+//line parser/parser.y:4322
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4325
+//line parser/parser.y:4326
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 797:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4329
+//line parser/parser.y:4330
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 798:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4335
+//line parser/parser.y:4336
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
 	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4339
+//line parser/parser.y:4340
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
 	case 800:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4346
+//line parser/parser.y:4347
 		{
 			yyVAL.arrayConstructor = &ArrayConstructor{Elements: yyDollar[3].arrayElements}
 		}
 	case 801:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4353
+//line parser/parser.y:4354
 		{
 			yyVAL.arrayElements = ArrayElements{yyDollar[1].arrayElement}
 		}
 	case 802:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4357
+//line parser/parser.y:4358
 		{
 			yyVAL.arrayElements = append(yyVAL.arrayElements, yyDollar[3].arrayElement)
 		}
 	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4364
+//line parser/parser.y:4365
 		{
 			yyVAL.arrayElement = NewStrVal(yyDollar[1].bytes)
 		}
 	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4370
+//line parser/parser.y:4371
 		{
 			yyVAL.strs = []string{string(yyDollar[1].bytes)}
 		}
 	case 805:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4374
+//line parser/parser.y:4375
 		{
 			yyVAL.strs = append(yyVAL.strs, string(yyDollar[3].bytes))
 		}
 	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4540
+//line parser/parser.y:4541
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
@@ -8150,7 +8151,7 @@ yydefault:
 		}
 	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4549
+//line parser/parser.y:4550
 		{
 			decNesting(yylex)
 		}
@@ -472,6 +472,7 @@ create_statement:
         Type: NewColIdent(""),
         Unique: bool($2[0]),
         Clustered: bool($2[1]),
+        Concurrently: true,
         Included: $11,
         Where: NewWhere(WhereStr, $12),
         Options: $13,
@@ -1,6 +1,12 @@
 package schema
 
-import "github.com/sqldef/sqldef/v2/parser"
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sqldef/sqldef/v2/parser"
+)
 
 type DDL interface {
 	Statement() string
@@ -57,6 +63,24 @@ type Table struct {
 	exclusions  []Exclusion
 	policies    []Policy
 	options     map[string]string
+	renamedFrom string // `-- @renamed from` annotation in the desired schema
+	partitionBy string // partition key of a partitioned table in PostgreSQL
+	partitionOf *PartitionOf
+	partition   *parser.PartitionOption // PARTITION BY of a table in MySQL
+	owner       string                  // set by `ALTER TABLE ... OWNER TO` in PostgreSQL
+	rowSecurity rowSecurity
+}
+
+// Row level security of a table in PostgreSQL, set by `ALTER TABLE ... ENABLE / FORCE ROW LEVEL SECURITY`
+type rowSecurity struct {
+	enabled bool
+	forced  bool
+}
+
+// Parent and bound of a partition in PostgreSQL
+type PartitionOf struct {
+	parent string
+	bound  string
 }
 
 type Column struct {
@@ -84,6 +108,7 @@ type Column struct {
 	identity      *Identity
 	sequence      *Sequence
 	generated     *Generated
+	renamedFrom   string // `-- @renamed from` annotation in the desired schema
 	// TODO: keyopt
 	// XXX: zerofill?
 }
@@ -100,6 +125,7 @@ type Index struct {
 	included          []string       // for MSSQL
 	clustered         bool           // for MSSQL
 	partition         IndexPartition // for MSSQL
+	concurrently      bool           // for Postgres `CREATE INDEX CONCURRENTLY`
 	options           []IndexOption
 }
 
@@ -172,15 +198,19 @@ type View struct {
 	definition   string
 	indexes      []Index
 	columns      []string
+	withNoData   bool // WITH NO DATA of a materialized view
+	normalized   bool // the definition is comparable to the dumped one
 }
 
 type Trigger struct {
-	statement string
-	name      string
-	tableName string
-	time      string
-	event     []string
-	body      []string
+	statement  string
+	name       string
+	tableName  string
+	time       string
+	event      []string
+	body       []string
+	constraint bool
+	definition string
 }
 
 type Value struct {
@@ -258,9 +288,66 @@ type CheckDefinition struct {
 
 // TODO: include type information
 type Type struct {
-	name       string
+	name          string
+	statement     string
+	enumValues    []string
+	attributes    []Column          // attributes of a composite type
+	renamedValues map[string]string // enum values mapped to the values renamed to them by `@renamed from`
+}
+
+// Domain of PostgreSQL. column holds the base type with the default and NOT NULL.
+type Domain struct {
+	statement string
+	name      string
+	column    Column
+	checks    []CheckDefinition
+}
+
+// GRANT of privileges, which is expanded to the privileges of each grantee on each object
+type Grant struct {
 	statement  string
-	enumValues []string
+	privileges []*Privilege
+}
+
+// Privileges of a grantee on an object
+type Privilege struct {
+	objectType string   // TABLE, SEQUENCE, SCHEMA, FUNCTION or PROCEDURE
+	name       string   // e.g. `public.users`, or `public` for SCHEMA
+	arguments  []string // argument types of functions and procedures
+	grantee    string   // role name, or PUBLIC
+	privileges []string // e.g. SELECT. ALL stands for ALL PRIVILEGES.
+	grantable  []string // privileges WITH GRANT OPTION
+}
+
+// PostgreSQL role. The statement is built from its options so that its password is never shown.
+type Role struct {
+	statement string
+	role      parser.Role
+}
+
+// GRANT of roles to members
+type GrantRole struct {
+	statement   string
+	memberships []Membership
+}
+
+type Membership struct {
+	role   string
+	member string
+}
+
+// ALTER TABLE ... ENABLE / DISABLE / FORCE / NO FORCE ROW LEVEL SECURITY
+type AlterRowSecurity struct {
+	statement string
+	tableName string
+	option    string
+}
+
+// ALTER TABLE ... OWNER TO
+type AlterOwner struct {
+	statement string
+	tableName string
+	owner     string
 }
 
 type Generated struct {
@@ -290,6 +377,34 @@ type Schema struct {
 	schema    parser.Schema
 }
 
+type CreateSequence struct {
+	statement string
+	name      string
+	sequence  *Sequence
+}
+
+type AlterSequence struct {
+	statement string
+	name      string
+	ownedBy   string
+}
+
+type Function struct {
+	statement  string
+	name       string
+	procedure  bool
+	arguments  []string
+	returns    string
+	definition string
+}
+
+type Event struct {
+	statement  string
+	name       string
+	starts     string
+	definition string
+}
+
 func (c *CreateTable) Statement() string {
 	return c.statement
 }
@@ -330,6 +445,44 @@ func (t *Type) Statement() string {
 	return t.statement
 }
 
+func (d *Domain) Statement() string {
+	return d.statement
+}
+
+func (g *Grant) Statement() string {
+	return g.statement
+}
+
+func (r *Role) Statement() string {
+	return r.statement
+}
+
+func (g *GrantRole) Statement() string {
+	return g.statement
+}
+
+func (a *AlterOwner) Statement() string {
+	return a.statement
+}
+
+func (a *AlterRowSecurity) Statement() string {
+	return a.statement
+}
+
+// Apply the option of ALTER TABLE ... ROW LEVEL SECURITY
+func (a *AlterRowSecurity) apply(table *Table) {
+	switch a.option {
+	case "ENABLE":
+		table.rowSecurity.enabled = true
+	case "DISABLE":
+		table.rowSecurity.enabled = false
+	case "FORCE":
+		table.rowSecurity.forced = true
+	case "NO FORCE":
+		table.rowSecurity.forced = false
+	}
+}
+
 func (t *Comment) Statement() string {
 	return t.statement
 }
@@ -342,6 +495,53 @@ func (t *Schema) Statement() string {
 	return t.statement
 }
 
+func (c *CreateSequence) Statement() string {
+	return c.statement
+}
+
+func (a *AlterSequence) Statement() string {
+	return a.statement
+}
+
+func (f *Function) Statement() string {
+	return f.statement
+}
+
+func (e *Event) Statement() string {
+	return e.statement
+}
+
+// Name and argument types identifying a function, e.g. public.add(int4, int4)
+func (f *Function) signature() string {
+	return fmt.Sprintf("%s(%s)", f.name, strings.Join(f.arguments, ", "))
+}
+
+// Add privileges, which are collapsed to ALL when it's granted
+func (p *Privilege) add(privileges []string, grantable bool) {
+	for _, privilege := range privileges {
+		if privilege == "ALL" {
+			p.privileges = []string{"ALL"}
+		} else if !p.hasPrivilege(privilege) {
+			p.privileges = append(p.privileges, privilege)
+		}
+		if grantable && !p.hasGrantOption(privilege) {
+			p.grantable = append(p.grantable, privilege)
+		}
+	}
+}
+
+func (p *Privilege) hasPrivilege(privilege string) bool {
+	return containsString(p.privileges, privilege) || containsString(p.privileges, "ALL")
+}
+
+func (p *Privilege) hasGrantOption(privilege string) bool {
+	return containsString(p.grantable, privilege) || containsString(p.grantable, "ALL")
+}
+
+func (p *Privilege) isSameTarget(other *Privilege) bool {
+	return p.objectType == other.objectType && p.name == other.name && slices.Equal(p.arguments, other.arguments) && p.grantee == other.grantee
+}
+
 func (t *Table) PrimaryKey() *Index {
 	for _, index := range t.indexes {
 		if index.primary {
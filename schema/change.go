@@ -0,0 +1,319 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sqldef/sqldef/v2/database"
+)
+
+// Kind of operation performed by a Change
+type ChangeKind string
+
+const (
+	ChangeCreateTable       = ChangeKind("create_table")
+	ChangeDropTable         = ChangeKind("drop_table")
+	ChangeRenameTable       = ChangeKind("rename_table")
+	ChangeAttachPartition   = ChangeKind("attach_partition") // Table is the parent of the partition
+	ChangeDetachPartition   = ChangeKind("detach_partition")
+	ChangeAddPartition      = ChangeKind("add_partition")
+	ChangeAlterPartition    = ChangeKind("alter_partition") // REORGANIZE PARTITION and COALESCE PARTITION of MySQL
+	ChangeDropPartition     = ChangeKind("drop_partition")
+	ChangeRepartition       = ChangeKind("repartition") // PARTITION BY and REMOVE PARTITIONING of MySQL
+	ChangeAlterTableOptions = ChangeKind("alter_table_options")
+	ChangeAlterTable        = ChangeKind("alter_table") // Changes of a table merged into one ALTER TABLE by MergeAlterTables
+	ChangeAddColumn         = ChangeKind("add_column")
+	ChangeDropColumn        = ChangeKind("drop_column")
+	ChangeAlterColumn       = ChangeKind("alter_column")
+	ChangeRenameColumn      = ChangeKind("rename_column")
+	ChangeAddIndex          = ChangeKind("add_index")
+	ChangeDropIndex         = ChangeKind("drop_index")
+	ChangeAddPrimaryKey     = ChangeKind("add_primary_key")
+	ChangeDropPrimaryKey    = ChangeKind("drop_primary_key")
+	ChangeAddConstraint     = ChangeKind("add_constraint") // UNIQUE constraints, and DEFAULT constraints for MSSQL
+	ChangeDropConstraint    = ChangeKind("drop_constraint")
+	ChangeAddForeignKey     = ChangeKind("add_foreign_key")
+	ChangeDropForeignKey    = ChangeKind("drop_foreign_key")
+	ChangeAddCheck          = ChangeKind("add_check")
+	ChangeDropCheck         = ChangeKind("drop_check")
+	ChangeAddExclusion      = ChangeKind("add_exclusion")
+	ChangeDropExclusion     = ChangeKind("drop_exclusion")
+	ChangeCreatePolicy      = ChangeKind("create_policy")
+	ChangeAlterPolicy       = ChangeKind("alter_policy")
+	ChangeDropPolicy        = ChangeKind("drop_policy")
+	ChangeAlterRowSecurity  = ChangeKind("alter_row_security")
+	ChangeCreateView        = ChangeKind("create_view")
+	ChangeReplaceView       = ChangeKind("replace_view")
+	ChangeDropView          = ChangeKind("drop_view")
+	ChangeCreateTrigger     = ChangeKind("create_trigger")
+	ChangeReplaceTrigger    = ChangeKind("replace_trigger")
+	ChangeDropTrigger       = ChangeKind("drop_trigger")
+	ChangeCreateType        = ChangeKind("create_type")
+	ChangeAlterType         = ChangeKind("alter_type")
+	ChangeAddAttribute      = ChangeKind("add_attribute")
+	ChangeAlterAttribute    = ChangeKind("alter_attribute")
+	ChangeDropAttribute     = ChangeKind("drop_attribute")
+	ChangeDropType          = ChangeKind("drop_type") // Also used for domains
+	ChangeComment           = ChangeKind("comment")
+	ChangeCreateExtension   = ChangeKind("create_extension")
+	ChangeDropExtension     = ChangeKind("drop_extension")
+	ChangeCreateSchema      = ChangeKind("create_schema")
+	ChangeCreateFunction    = ChangeKind("create_function")
+	ChangeReplaceFunction   = ChangeKind("replace_function")
+	ChangeDropFunction      = ChangeKind("drop_function") // Also used for procedures
+	ChangeCreateEvent       = ChangeKind("create_event")
+	ChangeReplaceEvent      = ChangeKind("replace_event")
+	ChangeDropEvent         = ChangeKind("drop_event")
+	ChangeCreateSequence    = ChangeKind("create_sequence")
+	ChangeAlterSequence     = ChangeKind("alter_sequence")
+	ChangeDropSequence      = ChangeKind("drop_sequence")
+	ChangeGrant             = ChangeKind("grant") // Name is the grantee. Also used for role memberships.
+	ChangeRevoke            = ChangeKind("revoke")
+	ChangeCreateRole        = ChangeKind("create_role")
+	ChangeAlterRole         = ChangeKind("alter_role")
+	ChangeDropRole          = ChangeKind("drop_role")
+	ChangeAlterOwner        = ChangeKind("alter_owner")
+)
+
+// A statement generated by GenerateIdempotentDDLs with what the generator knows about it.
+type Change struct {
+	DDL  string     `json:"ddl"`
+	Kind ChangeKind `json:"kind"`
+	// Table or view that the changed object belongs to. Empty for objects that don't belong to a table, e.g. types.
+	// For changes of a table or a view itself, this is the same as Name. For attributes of a composite type, this is the type.
+	Table string `json:"table,omitempty"`
+	// Name of the changed object, e.g. a column name for ChangeAddColumn.
+	Name string `json:"name,omitempty"`
+	// Whether the change may lose data or objects. Destructive changes are skipped unless --enable-drop.
+	Destructive bool `json:"destructive"`
+	// Whether the DDL can be executed in a transaction, e.g. false for CREATE INDEX CONCURRENTLY.
+	Transactional bool `json:"transactional"`
+	// Whether the change is only suggested and never executed, e.g. a rename guessed by detect_renames.
+	Suggested bool `json:"suggested,omitempty"`
+	// Whether the change is for a role absent in managed_roles, which is reported but never executed. Name is the role.
+	Unmanaged bool `json:"unmanaged,omitempty"`
+	// The desired-schema statement that caused this change. Empty when the change removes an object absent in the desired schema.
+	Source string `json:"source,omitempty"`
+
+	alterTable   bool   // The DDL is an `ALTER TABLE` statement that `ALGORITHM=` and `LOCK=` can be appended to
+	alterOptions string // `ALGORITHM=` and `LOCK=` appended to the DDL
+}
+
+// Return the DDLs of changes
+func ChangeDDLs(changes []Change) []string {
+	ddls := make([]string, len(changes))
+	for i, change := range changes {
+		ddls[i] = change.DDL
+	}
+	return ddls
+}
+
+func newChange(kind ChangeKind, table string, name string, ddl string) Change {
+	return Change{
+		DDL:           ddl,
+		Kind:          kind,
+		Table:         table,
+		Name:          name,
+		Destructive:   kind.isDestructive(),
+		Transactional: true,
+	}
+}
+
+func newAlterTableChange(kind ChangeKind, table string, name string, ddl string) Change {
+	change := newChange(kind, table, name, ddl)
+	change.alterTable = true
+	return change
+}
+
+// Return the change that creates the object defined by ddl from scratch, e.g. ChangeCreateTable for CreateTable.
+// This is used to describe each DDL of an exported schema.
+func ChangeForDDL(ddl DDL) Change {
+	var change Change
+	switch ddl := ddl.(type) {
+	case *CreateTable:
+		change = newChange(ChangeCreateTable, ddl.table.name, ddl.table.name, ddl.statement)
+	case *CreateIndex:
+		change = newChange(indexChangeKind(ddl.index), ddl.tableName, ddl.index.name, ddl.statement)
+		change.Transactional = !ddl.index.concurrently
+	case *AddIndex:
+		change = newAlterTableChange(indexChangeKind(ddl.index), ddl.tableName, ddl.index.name, ddl.statement)
+	case *AddPrimaryKey:
+		change = newAlterTableChange(ChangeAddPrimaryKey, ddl.tableName, ddl.index.name, ddl.statement)
+	case *AddForeignKey:
+		change = newAlterTableChange(ChangeAddForeignKey, ddl.tableName, ddl.foreignKey.constraintName, ddl.statement)
+	case *AddExclusion:
+		change = newAlterTableChange(ChangeAddExclusion, ddl.tableName, ddl.exclusion.constraintName, ddl.statement)
+	case *AddPolicy:
+		change = newChange(ChangeCreatePolicy, ddl.tableName, ddl.policy.name, ddl.statement)
+	case *View:
+		change = newChange(ChangeCreateView, ddl.name, ddl.name, ddl.statement)
+	case *Trigger:
+		change = newChange(ChangeCreateTrigger, ddl.tableName, ddl.name, ddl.statement)
+	case *Type:
+		change = newChange(ChangeCreateType, "", ddl.name, ddl.statement)
+	case *Domain:
+		change = newChange(ChangeCreateType, "", ddl.name, ddl.statement)
+	case *Comment:
+		change = newChange(ChangeComment, "", ddl.comment.Object, ddl.statement)
+	case *Extension:
+		change = newChange(ChangeCreateExtension, "", ddl.extension.Name, ddl.statement)
+	case *Schema:
+		change = newChange(ChangeCreateSchema, "", ddl.schema.Name, ddl.statement)
+	case *Function:
+		change = newChange(ChangeCreateFunction, "", ddl.signature(), ddl.statement)
+	case *Event:
+		change = newChange(ChangeCreateEvent, "", ddl.name, ddl.statement)
+	case *CreateSequence:
+		change = newChange(ChangeCreateSequence, "", ddl.name, ddl.statement)
+	case *AlterSequence:
+		change = newChange(ChangeAlterSequence, "", ddl.name, ddl.statement)
+	case *Grant:
+		change = newChange(ChangeGrant, "", "", ddl.statement)
+	case *Role:
+		change = newChange(ChangeCreateRole, "", ddl.role.Name, ddl.statement)
+	case *GrantRole:
+		change = newChange(ChangeGrant, "", "", ddl.statement)
+	case *AlterRowSecurity:
+		change = newChange(ChangeAlterRowSecurity, ddl.tableName, ddl.tableName, ddl.statement)
+	case *AlterOwner:
+		change = newChange(ChangeAlterOwner, ddl.tableName, ddl.owner, ddl.statement)
+	default:
+		change = newChange("", "", "", ddl.Statement())
+	}
+	return change
+}
+
+func indexChangeKind(index Index) ChangeKind {
+	if index.primary {
+		return ChangeAddPrimaryKey
+	}
+	return ChangeAddIndex
+}
+
+// Set the statement of desired as the source of changes
+func withSource(changes []Change, desired DDL) []Change {
+	for i := range changes {
+		changes[i].Source = desired.Statement()
+	}
+	return changes
+}
+
+// Objects dropped by destructive changes. Each of them can be enabled by `enable_drop` in the config.
+// Dropping constraints, policies and extensions, and revoking privileges are not considered destructive since no data is lost by them.
+var droppedObjects = map[ChangeKind]string{
+	ChangeDropTable:     "table",
+	ChangeDropColumn:    "column",
+	ChangeDropIndex:     "index",
+	ChangeDropPartition: "partition",
+	ChangeDropView:      "view",
+	ChangeDropTrigger:   "trigger",
+	ChangeDropFunction:  "function",
+	ChangeDropEvent:     "event",
+	ChangeDropSequence:  "sequence",
+	ChangeDropType:      "type",
+	ChangeDropAttribute: "attribute",
+	ChangeDropRole:      "role",
+}
+
+func (k ChangeKind) isDestructive() bool {
+	_, ok := droppedObjects[k]
+	return ok
+}
+
+// Return the type of the object dropped by a destructive change, e.g. "column" for ChangeDropColumn.
+// This returns an empty string for non-destructive changes.
+func (k ChangeKind) DroppedObject() string {
+	return droppedObjects[k]
+}
+
+// Return the clauses following `ALTER TABLE <table>` of the change, e.g. "ADD COLUMN `c` int", when it's run by
+// config.OnlineSchemaChange instead of being executed. Renaming tables and foreign keys are always executed since
+// online schema change tools like gh-ost don't support them.
+func OnlineSchemaChangeClause(mode GeneratorMode, change Change, config database.GeneratorConfig) (string, bool) {
+	if mode != GeneratorModeMysql || config.OnlineSchemaChange == "" {
+		return "", false
+	}
+	if len(config.OnlineSchemaChangeTables) > 0 && !containsRegexpString(config.OnlineSchemaChangeTables, change.Table) {
+		return "", false
+	}
+	return alterTableClause(change)
+}
+
+// Merge consecutive `ALTER TABLE` changes of a MySQL table into one change of ChangeAlterTable, e.g.
+// "ALTER TABLE `t` ADD COLUMN `c` int, DROP INDEX `i`", since each of them may rebuild the table.
+// Changes that skipped returns true for are left in place without ending the merge. A change of a column already
+// changed in the statement starts a new one, which keeps the order of AUTO_INCREMENT removed before and added after
+// changing keys. Like OnlineSchemaChangeClause, renaming tables and foreign keys are not merged.
+func MergeAlterTables(changes []Change, skipped func(Change) bool) []Change {
+	merged := []Change{}
+	statement := -1 // index of the change in merged that the following ones can be merged into
+	var clauses []string
+	var columns map[string]bool // columns changed in the statement
+	for _, change := range changes {
+		if skipped != nil && skipped(change) {
+			merged = append(merged, change)
+			continue
+		}
+		clause, ok := alterTableClause(change)
+		if !ok {
+			merged = append(merged, change)
+			statement = -1
+			continue
+		}
+
+		column := change.Kind == ChangeAddColumn || change.Kind == ChangeDropColumn || change.Kind == ChangeAlterColumn || change.Kind == ChangeRenameColumn
+		if statement >= 0 && merged[statement].Table == change.Table && merged[statement].alterOptions == change.alterOptions && !(column && columns[change.Name]) {
+			clauses = append(clauses, clause)
+			m := &merged[statement]
+			m.DDL = fmt.Sprintf("ALTER TABLE `%s` %s%s", change.Table, strings.Join(clauses, ", "), change.alterOptions)
+			m.Kind = ChangeAlterTable
+			m.Name = change.Table
+			m.Destructive = m.Destructive || change.Destructive
+			m.Transactional = m.Transactional && change.Transactional
+			if m.Source == "" {
+				m.Source = change.Source
+			}
+		} else {
+			statement = len(merged)
+			clauses = []string{clause}
+			columns = map[string]bool{}
+			merged = append(merged, change)
+		}
+		if column {
+			columns[change.Name] = true
+		}
+	}
+	return merged
+}
+
+// Return the clauses of a MySQL `ALTER TABLE` change following the table name, excluding `ALGORITHM=` and `LOCK=`.
+func alterTableClause(change Change) (string, bool) {
+	if !change.alterTable {
+		return "", false
+	}
+	switch change.Kind {
+	case ChangeRenameTable, ChangeAddForeignKey, ChangeDropForeignKey:
+		return "", false
+	}
+	prefix := fmt.Sprintf("ALTER TABLE `%s` ", change.Table)
+	if !strings.HasPrefix(change.DDL, prefix) || !strings.HasSuffix(change.DDL, change.alterOptions) {
+		return "", false
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(change.DDL, prefix), change.alterOptions), true
+}
+
+func validateEnableDrop(objects []string) error {
+	var validObjects []string
+	for _, object := range droppedObjects {
+		validObjects = append(validObjects, object)
+	}
+	sort.Strings(validObjects)
+
+	for _, object := range objects {
+		if !containsString(validObjects, object) {
+			return fmt.Errorf("unknown object '%s' in enable_drop (expected one of: %s)", object, strings.Join(validObjects, ", "))
+		}
+	}
+	return nil
+}
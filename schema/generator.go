@@ -4,12 +4,16 @@ package schema
 import (
 	"fmt"
 	"log"
+	"math"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/sqldef/sqldef/v2/database"
+	"github.com/sqldef/sqldef/v2/parser"
 )
 
 type GeneratorMode int
@@ -48,6 +52,9 @@ type Generator struct {
 	desiredTypes []*Type
 	currentTypes []*Type
 
+	desiredDomains []*Domain
+	currentDomains []*Domain
+
 	currentComments []*Comment
 
 	desiredExtensions []*Extension
@@ -56,14 +63,45 @@ type Generator struct {
 	desiredSchemas []*Schema
 	currentSchemas []*Schema
 
+	desiredFunctions []*Function
+	currentFunctions []*Function
+
+	desiredEvents []*Event
+	currentEvents []*Event
+
+	desiredSequences []*CreateSequence
+	currentSequences []*CreateSequence
+
+	desiredPrivileges []*Privilege
+	currentPrivileges []*Privilege
+
+	desiredRoles []*Role
+	currentRoles []*Role
+
+	desiredMemberships []Membership
+	currentMemberships []Membership
+
 	defaultSchema string
 
 	algorithm string
 	lock      string
+
+	detectRenames       bool
+	recreateEnums       bool
+	managedRoles        []string
+	manageAutoIncrement bool
+	allowRepartition    bool
+
+	onlineSchemaChange       string
+	onlineSchemaChangeTables []string
 }
 
 // Parse argument DDLs and call `generateDDLs()`
-func GenerateIdempotentDDLs(mode GeneratorMode, sqlParser database.Parser, desiredSQL string, currentSQL string, config database.GeneratorConfig, defaultSchema string) ([]string, error) {
+func GenerateIdempotentDDLs(mode GeneratorMode, sqlParser database.Parser, desiredSQL string, currentSQL string, config database.GeneratorConfig, defaultSchema string) ([]Change, error) {
+	if err := validateEnableDrop(config.EnableDrop); err != nil {
+		return nil, err
+	}
+
 	// TODO: invalidate duplicated tables, columns
 	desiredDDLs, err := ParseDDLs(mode, sqlParser, desiredSQL, defaultSchema)
 	if err != nil {
@@ -79,57 +117,104 @@ func GenerateIdempotentDDLs(mode GeneratorMode, sqlParser database.Parser, desir
 	currentDDLs = FilterTables(currentDDLs, config)
 	currentDDLs = FilterViews(currentDDLs, config)
 
-	tables, views, triggers, types, comments, extensions, schemas, err := aggregateDDLsToSchema(currentDDLs)
+	tables, views, triggers, types, domains, comments, extensions, schemas, functions, events, sequences, privileges, roles, memberships, err := aggregateDDLsToSchema(currentDDLs)
 	if err != nil {
 		return nil, err
 	}
 
 	generator := Generator{
-		mode:              mode,
-		desiredTables:     []*Table{},
-		currentTables:     tables,
-		desiredViews:      []*View{},
-		currentViews:      views,
-		desiredTriggers:   []*Trigger{},
-		currentTriggers:   triggers,
-		desiredTypes:      []*Type{},
-		currentTypes:      types,
-		currentComments:   comments,
-		desiredExtensions: []*Extension{},
-		currentExtensions: extensions,
-		desiredSchemas:    []*Schema{},
-		currentSchemas:    schemas,
-		defaultSchema:     defaultSchema,
-		algorithm:         config.Algorithm,
-		lock:              config.Lock,
+		mode:               mode,
+		desiredTables:      []*Table{},
+		currentTables:      tables,
+		desiredViews:       []*View{},
+		currentViews:       views,
+		desiredTriggers:    []*Trigger{},
+		currentTriggers:    triggers,
+		desiredTypes:       []*Type{},
+		currentTypes:       types,
+		desiredDomains:     []*Domain{},
+		currentDomains:     domains,
+		currentComments:    comments,
+		desiredExtensions:  []*Extension{},
+		currentExtensions:  extensions,
+		desiredSchemas:     []*Schema{},
+		currentSchemas:     schemas,
+		desiredFunctions:   []*Function{},
+		currentFunctions:   functions,
+		desiredEvents:      []*Event{},
+		currentEvents:      events,
+		desiredSequences:   []*CreateSequence{},
+		currentSequences:   sequences,
+		desiredPrivileges:  []*Privilege{},
+		currentPrivileges:  privileges,
+		desiredRoles:       []*Role{},
+		currentRoles:       roles,
+		currentMemberships: memberships,
+		defaultSchema:      defaultSchema,
+		algorithm:          config.Algorithm,
+		lock:               config.Lock,
+		detectRenames:      config.DetectRenames,
+		recreateEnums:      config.RecreateEnums,
+		managedRoles:        config.ManagedRoles,
+		manageAutoIncrement: config.ManageAutoIncrement,
+		allowRepartition:    config.AllowRepartition,
+		onlineSchemaChange:       config.OnlineSchemaChange,
+		onlineSchemaChangeTables: config.OnlineSchemaChangeTables,
 	}
 	return generator.generateDDLs(desiredDDLs)
 }
 
 // Main part of DDL genearation
-func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
+func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]Change, error) {
 	// These variables are used to control the output order of the DDL.
 	// `CREATE SCHEMA` should execute first, and DDLs that add indexes and foreign keys should execute last.
 	// Other ddls are stored in interDDLs.
-	createExtensionDDLs := []string{}
-	createSchemaDDLs := []string{}
-	interDDLs := []string{}
-	indexDDLs := []string{}
-	foreignKeyDDLs := []string{}
-	exclusionDDLs := []string{}
+	createRoleDDLs := []Change{}
+	createExtensionDDLs := []Change{}
+	createSchemaDDLs := []Change{}
+	interDDLs := []Change{}
+	indexDDLs := []Change{}
+	foreignKeyDDLs := []Change{}
+	exclusionDDLs := []Change{}
 
 	// Incrementally examine desiredDDLs
 	for _, ddl := range desiredDDLs {
 		switch desired := ddl.(type) {
 		case *CreateTable:
-			if currentTable := findTableByName(g.currentTables, desired.table.name); currentTable != nil {
+			currentTable := findTableByName(g.currentTables, desired.table.name)
+			if currentTable == nil && desired.table.renamedFrom != "" {
+				if renamedTable := findTableByName(g.currentTables, desired.table.renamedFrom); renamedTable != nil && g.canRenameTable(renamedTable.name, desired.table.name) {
+					currentTable = renamedTable
+				}
+			}
+			if currentTable == nil {
+				// Revert a rename annotated in the current schema, e.g. when generating a rollback
+				if renamedTable := findTableByRenamedFrom(g.currentTables, desired.table.name); renamedTable != nil && g.canRenameTable(renamedTable.name, desired.table.name) && !isTableDesired(desiredDDLs, renamedTable.name) {
+					currentTable = renamedTable
+				}
+			}
+			if currentTable != nil && currentTable.name != desired.table.name {
+				interDDLs = append(interDDLs, withSource([]Change{g.generateRenameTable(currentTable.name, desired.table.name)}, ddl)...)
+				currentTable.name = desired.table.name
+			}
+			if currentTable != nil && g.mode == GeneratorModePostgres {
+				partitionDDLs, err := g.generateDDLsForPartition(currentTable, &desired.table)
+				if err != nil {
+					return nil, err
+				}
+				interDDLs = append(interDDLs, withSource(partitionDDLs, ddl)...)
+			}
+			if currentTable != nil && desired.table.partitionOf != nil {
+				// Columns of a partition are the ones of its parent
+				mergeTable(currentTable, desired.table)
+			} else if currentTable != nil {
 				// Table already exists, guess required DDLs.
 				tableDDLs, err := g.generateDDLsForCreateTable(*currentTable, *desired)
 				if err != nil {
 					return nil, err
 				}
-				for _, tableDDL := range tableDDLs {
-					if isAddConstraintForeignKey(tableDDL) {
+				for _, tableDDL := range withSource(tableDDLs, ddl) {
+					if tableDDL.Kind == ChangeAddForeignKey {
 						foreignKeyDDLs = append(foreignKeyDDLs, tableDDL)
 					} else {
 						interDDLs = append(interDDLs, tableDDL)
@@ -138,7 +223,7 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				mergeTable(currentTable, desired.table)
 			} else {
 				// Table not found, create table.
-				interDDLs = append(interDDLs, desired.statement)
+				interDDLs = append(interDDLs, withSource([]Change{newChange(ChangeCreateTable, desired.table.name, desired.table.name, desired.statement)}, ddl)...)
 				table := desired.table // copy table
 				g.currentTables = append(g.currentTables, &table)
 			}
@@ -149,86 +234,152 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 			if err != nil {
 				return nil, err
 			}
-			indexDDLs = append(indexDDLs, idxDDLs...)
+			indexDDLs = append(indexDDLs, withSource(idxDDLs, ddl)...)
 		case *AddIndex:
 			idxDDLs, err := g.generateDDLsForCreateIndex(desired.tableName, desired.index, "ALTER TABLE", ddl.Statement())
 			if err != nil {
 				return nil, err
 			}
-			indexDDLs = append(indexDDLs, idxDDLs...)
+			indexDDLs = append(indexDDLs, withSource(idxDDLs, ddl)...)
 		case *AddForeignKey:
 			fkeyDDLs, err := g.generateDDLsForAddForeignKey(desired.tableName, desired.foreignKey, "ALTER TABLE", ddl.Statement())
 			if err != nil {
 				return nil, err
 			}
-			foreignKeyDDLs = append(foreignKeyDDLs, fkeyDDLs...)
+			foreignKeyDDLs = append(foreignKeyDDLs, withSource(fkeyDDLs, ddl)...)
 		case *AddExclusion:
 			exDDLs, err := g.generateDDLsForAddExclusion(desired.tableName, desired.exclusion, "ALTER TABLE", ddl.Statement())
 			if err != nil {
 				return nil, err
 			}
-			exclusionDDLs = append(exclusionDDLs, exDDLs...)
+			exclusionDDLs = append(exclusionDDLs, withSource(exDDLs, ddl)...)
 		case *AddPolicy:
 			policyDDLs, err := g.generateDDLsForCreatePolicy(desired.tableName, desired.policy, "CREATE POLICY", ddl.Statement())
 			if err != nil {
 				return nil, err
 			}
-			interDDLs = append(interDDLs, policyDDLs...)
+			interDDLs = append(interDDLs, withSource(policyDDLs, ddl)...)
 		case *View:
 			viewDDLs, err := g.generateDDLsForCreateView(desired.name, desired)
 			if err != nil {
 				return nil, err
 			}
-			interDDLs = append(interDDLs, viewDDLs...)
+			interDDLs = append(interDDLs, withSource(viewDDLs, ddl)...)
 		case *Trigger:
 			triggerDDLs, err := g.generateDDLsForCreateTrigger(desired.name, desired)
 			if err != nil {
 				return nil, err
 			}
-			interDDLs = append(interDDLs, triggerDDLs...)
+			interDDLs = append(interDDLs, withSource(triggerDDLs, ddl)...)
 		case *Type:
-			typeDDLs, err := g.generateDDLsForCreateType(desired)
+			typeDDLs, err := g.generateDDLsForCreateType(desired, desiredDDLs)
 			if err != nil {
 				return nil, err
 			}
-			interDDLs = append(interDDLs, typeDDLs...)
+			interDDLs = append(interDDLs, withSource(typeDDLs, ddl)...)
+		case *Domain:
+			domainDDLs, err := g.generateDDLsForCreateDomain(desired)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, withSource(domainDDLs, ddl)...)
 		case *Comment:
 			commentDDLs, err := g.generateDDLsForComment(desired)
 			if err != nil {
 				return nil, err
 			}
-			interDDLs = append(interDDLs, commentDDLs...)
+			interDDLs = append(interDDLs, withSource(commentDDLs, ddl)...)
 		case *Extension:
 			extensionDDLs, err := g.generateDDLsForExtension(desired)
 			if err != nil {
 				return nil, err
 			}
-			createExtensionDDLs = append(createExtensionDDLs, extensionDDLs...)
+			createExtensionDDLs = append(createExtensionDDLs, withSource(extensionDDLs, ddl)...)
 		case *Schema:
 			schemaDDLs, err := g.generateDDLsForSchema(desired)
 			if err != nil {
 				return nil, err
 			}
-			createSchemaDDLs = append(createSchemaDDLs, schemaDDLs...)
+			createSchemaDDLs = append(createSchemaDDLs, withSource(schemaDDLs, ddl)...)
+		case *Function:
+			functionDDLs, err := g.generateDDLsForFunction(desired)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, withSource(functionDDLs, ddl)...)
+		case *Event:
+			eventDDLs, err := g.generateDDLsForEvent(desired)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, withSource(eventDDLs, ddl)...)
+		case *CreateSequence:
+			sequenceDDLs, err := g.generateDDLsForCreateSequence(desired)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, withSource(sequenceDDLs, ddl)...)
+		case *AlterSequence:
+			// The owner is compared after all tables are created
+			desiredSequence := findSequenceByName(g.desiredSequences, desired.name)
+			if desiredSequence == nil {
+				return nil, fmt.Errorf("ALTER SEQUENCE is performed before CREATE SEQUENCE: %s", desired.statement)
+			}
+			desiredSequence.sequence.OwnedBy = desired.ownedBy
+		case *Grant:
+			interDDLs = append(interDDLs, withSource(g.generateDDLsForGrant(desired), ddl)...)
+		case *Role:
+			roleDDLs, err := g.generateDDLsForCreateRole(desired)
+			if err != nil {
+				return nil, err
+			}
+			createRoleDDLs = append(createRoleDDLs, withSource(roleDDLs, ddl)...)
+		case *GrantRole:
+			interDDLs = append(interDDLs, withSource(g.generateDDLsForGrantRole(desired), ddl)...)
+		case *AlterRowSecurity:
+			// Row level security is compared after all policies are created
+			desiredTable := findTableByName(g.desiredTables, desired.tableName)
+			if desiredTable == nil {
+				return nil, fmt.Errorf("ALTER TABLE ROW LEVEL SECURITY is performed before CREATE TABLE: %s", desired.statement)
+			}
+			desired.apply(desiredTable)
+		case *AlterOwner:
+			ownerDDLs, err := g.generateDDLsForAlterOwner(desired)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, withSource(ownerDDLs, ddl)...)
 		default:
 			return nil, fmt.Errorf("unexpected ddl type in generateDDLs: %v", desired)
 		}
 	}
 
-	ddls := []string{}
+	ddls := []Change{}
+	ddls = append(ddls, createRoleDDLs...)
 	ddls = append(ddls, createExtensionDDLs...)
 	ddls = append(ddls, createSchemaDDLs...)
 	ddls = append(ddls, interDDLs...)
+	ddls = append(ddls, g.generateDDLsForSequenceOwners()...)
+	ddls = append(ddls, g.generateDDLsForRowSecurity()...)
 	ddls = append(ddls, indexDDLs...)
 	ddls = append(ddls, foreignKeyDDLs...)
 	ddls = append(ddls, exclusionDDLs...)
 
+	// Revoke obsoleted privileges and memberships before their objects are dropped
+	ddls = append(ddls, g.generateDDLsForRevoke()...)
+	ddls = append(ddls, g.generateDDLsForRevokeRole()...)
+
 	// Clean up obsoleted tables, indexes, columns
 	for _, currentTable := range g.currentTables {
 		desiredTable := findTableByName(g.desiredTables, currentTable.name)
+		if desiredTable == nil && currentTable.partitionOf != nil && findTableByName(g.desiredTables, currentTable.partitionOf.parent) == nil {
+			// The partition is dropped with its parent
+			g.currentTables = removeTableByName(g.currentTables, currentTable.name)
+			continue
+		}
 		if desiredTable == nil {
 			// Obsoleted table found. Drop table.
-			ddls = append(ddls, fmt.Sprintf("DROP TABLE %s", g.escapeTableName(currentTable.name)))
+			ddls = append(ddls, newChange(ChangeDropTable, currentTable.name, currentTable.name, fmt.Sprintf("DROP TABLE %s", g.escapeTableName(currentTable.name))))
 			g.currentTables = removeTableByName(g.currentTables, currentTable.name)
 			continue
 		}
@@ -251,7 +402,7 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				continue // Exclusion constraint is expected to exist.
 			}
 
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(exclusion.constraintName)))
+			ddls = append(ddls, newAlterTableChange(ChangeDropExclusion, currentTable.name, exclusion.constraintName, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(exclusion.constraintName))))
 		}
 
 		// Check indexes
@@ -278,7 +429,7 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 
 		// Check columns.
 		for _, column := range currentTable.columns {
-			if _, exist := desiredTable.columns[column.name]; exist {
+			if _, exist := desiredTable.columns[column.name]; exist || desiredTable.partitionOf != nil {
 				continue // Column is expected to exist.
 			}
 
@@ -293,7 +444,7 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 			if containsString(convertPolicyNames(desiredTable.policies), policy.name) {
 				continue
 			}
-			ddls = append(ddls, fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(policy.name), g.escapeTableName(currentTable.name)))
+			ddls = append(ddls, newChange(ChangeDropPolicy, currentTable.name, policy.name, fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(policy.name), g.escapeTableName(currentTable.name))))
 		}
 
 		// Check checks.
@@ -302,21 +453,27 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				continue
 			}
 			if g.mode != GeneratorModeMysql { // workaround. inline CHECK should be converted to out-of-place CONSTRAINT to fix this.
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(check.constraintName)))
+				ddls = append(ddls, newAlterTableChange(ChangeDropCheck, currentTable.name, check.constraintName, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(check.constraintName))))
 			}
 		}
 	}
 
 	// Clean up obsoleted views
 	for _, currentView := range g.currentViews {
-		if containsString(convertViewNames(g.desiredViews), currentView.name) {
+		if desiredView := findViewByName(g.desiredViews, currentView.name); desiredView != nil {
+			// Check indexes of materialized views
+			for _, index := range currentView.indexes {
+				if !containsString(convertIndexesToIndexNames(desiredView.indexes), index.name) {
+					ddls = append(ddls, g.generateDropIndexChange(currentView.name, index.name, index.constraint))
+				}
+			}
 			continue
 		}
 		if currentView.viewType == "MATERIALIZED VIEW" {
-			ddls = append(ddls, fmt.Sprintf("DROP MATERIALIZED VIEW %s", g.escapeTableName(currentView.name)))
+			ddls = append(ddls, newChange(ChangeDropView, currentView.name, currentView.name, fmt.Sprintf("DROP MATERIALIZED VIEW %s", g.escapeTableName(currentView.name))))
 			continue
 		}
-		ddls = append(ddls, fmt.Sprintf("DROP VIEW %s", g.escapeTableName(currentView.name)))
+		ddls = append(ddls, newChange(ChangeDropView, currentView.name, currentView.name, fmt.Sprintf("DROP VIEW %s", g.escapeTableName(currentView.name))))
 	}
 
 	// Clean up obsoleted extensions
@@ -324,33 +481,93 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 		if containsString(convertExtensionNames(g.desiredExtensions), currentExtension.extension.Name) {
 			continue
 		}
-		ddls = append(ddls, fmt.Sprintf("DROP EXTENSION %s", g.escapeSQLName(currentExtension.extension.Name)))
+		ddls = append(ddls, newChange(ChangeDropExtension, "", currentExtension.extension.Name, fmt.Sprintf("DROP EXTENSION %s", g.escapeSQLName(currentExtension.extension.Name))))
 	}
 
 	// Clean up obsoleted triggers
 	for _, currentTrigger := range g.currentTriggers {
-		if g.mode != GeneratorModeSQLite3 {
+		if g.mode != GeneratorModeSQLite3 && g.mode != GeneratorModePostgres {
 			continue
 		}
-		desitedTrigger := findTriggerByName(g.desiredTriggers, currentTrigger.name)
+		if g.mode == GeneratorModePostgres && findTableByName(g.desiredTables, currentTrigger.tableName) == nil &&
+			!containsString(convertViewNames(g.desiredViews), currentTrigger.tableName) {
+			continue // The trigger is dropped with its table
+		}
+		desitedTrigger := g.findTrigger(g.desiredTriggers, currentTrigger)
 		if desitedTrigger == nil {
-			ddls = append(ddls, fmt.Sprintf("DROP TRIGGER %s", g.escapeSQLName(currentTrigger.name)))
+			ddls = append(ddls, newChange(ChangeDropTrigger, currentTrigger.tableName, currentTrigger.name, g.generateDropTrigger(currentTrigger)))
+			continue
+		}
+	}
+
+	// Clean up obsoleted functions
+	for _, currentFunction := range g.currentFunctions {
+		if g.findFunction(g.desiredFunctions, currentFunction.signature(), currentFunction.procedure) != nil {
+			continue
+		}
+		ddls = append(ddls, newChange(ChangeDropFunction, "", currentFunction.signature(), g.generateDropFunction(currentFunction)))
+	}
+
+	// Clean up obsoleted events
+	for _, currentEvent := range g.currentEvents {
+		if findEventByName(g.desiredEvents, currentEvent.name) != nil {
+			continue
+		}
+		ddls = append(ddls, newChange(ChangeDropEvent, "", currentEvent.name, fmt.Sprintf("DROP EVENT %s", g.escapeTableName(currentEvent.name))))
+	}
+
+	// Clean up obsoleted sequences
+	for _, currentSequence := range g.currentSequences {
+		if findSequenceByName(g.desiredSequences, currentSequence.name) != nil {
 			continue
 		}
+		if owner := currentSequence.sequence.OwnedBy; owner != "" {
+			ownerTable, ownerColumn := splitSequenceOwner(owner)
+			if desiredTable := findTableByName(g.desiredTables, ownerTable); desiredTable == nil || desiredTable.columns[ownerColumn] == nil {
+				continue // The sequence is dropped with the column owning it
+			}
+		}
+		ddls = append(ddls, newChange(ChangeDropSequence, "", currentSequence.name, fmt.Sprintf("DROP SEQUENCE %s", g.escapeTableName(currentSequence.name))))
+	}
+
+	// Clean up obsoleted types. Composite types and domains are dropped before enums that they may use.
+	for _, currentType := range g.currentTypes {
+		if currentType.enumValues == nil && findTypeByName(g.desiredTypes, currentType.name) == nil {
+			ddls = append(ddls, newChange(ChangeDropType, "", currentType.name, fmt.Sprintf("DROP TYPE %s", g.escapeTableName(currentType.name))))
+		}
+	}
+	for _, currentDomain := range g.currentDomains {
+		if findDomainByName(g.desiredDomains, currentDomain.name) == nil {
+			ddls = append(ddls, newChange(ChangeDropType, "", currentDomain.name, fmt.Sprintf("DROP DOMAIN %s", g.escapeTableName(currentDomain.name))))
+		}
+	}
+	for _, currentType := range g.currentTypes {
+		if currentType.enumValues != nil && findTypeByName(g.desiredTypes, currentType.name) == nil {
+			ddls = append(ddls, newChange(ChangeDropType, "", currentType.name, fmt.Sprintf("DROP TYPE %s", g.escapeTableName(currentType.name))))
+		}
+	}
+
+	// Clean up obsoleted roles after the objects that they may own are dropped
+	for _, currentRole := range g.currentRoles {
+		if g.isManagedRole(currentRole.role.Name) && findRoleByName(g.desiredRoles, currentRole.role.Name) == nil {
+			ddls = append(ddls, newChange(ChangeDropRole, "", currentRole.role.Name, fmt.Sprintf("DROP ROLE %s", g.escapeSQLName(currentRole.role.Name))))
+		}
 	}
 
 	if isValidAlgorithm(g.algorithm) {
 		for i := range ddls {
-			if strings.HasPrefix(ddls[i], "ALTER TABLE") {
-				ddls[i] += ", ALGORITHM=" + strings.ToUpper(g.algorithm)
+			if ddls[i].alterTable && !g.isOnlineSchemaChange(ddls[i]) {
+				ddls[i].alterOptions += ", ALGORITHM=" + strings.ToUpper(g.algorithm)
+				ddls[i].DDL += ", ALGORITHM=" + strings.ToUpper(g.algorithm)
 			}
 		}
 	}
 
 	if isValidLock(g.lock) {
 		for i := range ddls {
-			if strings.HasPrefix(ddls[i], "ALTER TABLE") {
-				ddls[i] += ", LOCK=" + strings.ToUpper(g.lock)
+			if ddls[i].alterTable && !g.isOnlineSchemaChange(ddls[i]) {
+				ddls[i].alterOptions += ", LOCK=" + strings.ToUpper(g.lock)
+				ddls[i].DDL += ", LOCK=" + strings.ToUpper(g.lock)
 			}
 		}
 	}
@@ -358,30 +575,41 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 	return ddls, nil
 }
 
-func (g *Generator) generateDDLsForAbsentColumn(currentTable *Table, columnName string) []string {
-	ddls := []string{}
+func (g *Generator) generateDDLsForAbsentColumn(currentTable *Table, columnName string) []Change {
+	ddls := []Change{}
 
 	// Only MSSQL has column default constraints. They need to be deleted before dropping the column.
 	if g.mode == GeneratorModeMssql {
 		for _, column := range currentTable.columns {
 			if column.name == columnName && column.defaultDef != nil && column.defaultDef.constraintName != "" {
 				ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(column.defaultDef.constraintName))
-				ddls = append(ddls, ddl)
+				ddls = append(ddls, newAlterTableChange(ChangeDropConstraint, currentTable.name, column.defaultDef.constraintName, ddl))
 			}
 		}
 	}
 
 	ddl := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(currentTable.name), g.escapeSQLName(columnName))
-	return append(ddls, ddl)
+	return append(ddls, newAlterTableChange(ChangeDropColumn, currentTable.name, columnName, ddl))
 }
 
 // In the caller, `mergeTable` manages `g.currentTables`.
-func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired CreateTable) ([]string, error) {
-	ddls := []string{}
+func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired CreateTable) ([]Change, error) {
+	ddls := []Change{}
 	var desiredColumns = make([]*Column, len(desired.table.columns))
 	for _, col := range desired.table.columns {
 		desiredColumns[col.position] = col
 	}
+	renamedColumns := g.findRenamedColumns(currentTable, desired.table)
+	if g.detectRenames && len(renamedColumns) == 0 {
+		if currentName, desiredName, ok := g.guessRenamedColumn(currentTable, desired.table); ok {
+			suggestion, err := g.generateRenameColumn(desired.table.name, *currentTable.columns[currentName], desiredName)
+			if err != nil {
+				return ddls, err
+			}
+			suggestion.Suggested = true
+			ddls = append(ddls, suggestion)
+		}
+	}
 
 	// Examine each column
 	for _, desiredColumnPtr := range desiredColumns {
@@ -389,6 +617,16 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 		desiredColumn := *desiredColumnPtr
 
 		currentColumn := findColumnByName(currentTable.columns, desiredColumn.name)
+		if currentColumn == nil {
+			if renamedFrom, ok := renamedColumns[desiredColumn.name]; ok {
+				change, err := g.generateRenameColumn(desired.table.name, *currentTable.columns[renamedFrom], desiredColumn.name)
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, change)
+				currentColumn = renameColumn(&currentTable, renamedFrom, desiredColumn.name)
+			}
+		}
 		if currentColumn == nil || !currentColumn.autoIncrement {
 			// We may not be able to add AUTO_INCREMENT yet. It will be added after adding keys (primary or not) at the "Add new AUTO_INCREMENT" place.
 			// prevent to
@@ -417,7 +655,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				ddl += after
 			}
 
-			ddls = append(ddls, ddl)
+			ddls = append(ddls, newAlterTableChange(ChangeAddColumn, desired.table.name, desiredColumn.name, ddl))
 		} else {
 			// Change column data type or order as needed.
 			switch g.mode {
@@ -441,7 +679,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 							after = " AFTER " + g.escapeSQLName(desiredColumns[desiredColumn.position-1].name)
 						}
 						ddl2 += after
-						ddls = append(ddls, ddl1, ddl2)
+						ddls = append(ddls, newAlterTableChange(ChangeDropColumn, desired.table.name, currentColumn.name, ddl1), newAlterTableChange(ChangeAddColumn, desired.table.name, desiredColumn.name, ddl2))
 					} else {
 						ddl := fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), definition)
 						if changeOrder {
@@ -451,7 +689,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 							}
 							ddl += after
 						}
-						ddls = append(ddls, ddl)
+						ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, desired.table.name, currentColumn.name, ddl))
 					}
 				}
 
@@ -459,20 +697,20 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				currentIndex := findIndexByName(currentTable.indexes, desiredColumn.name)
 				if desiredColumn.keyOption.isUnique() && !currentColumn.keyOption.isUnique() && currentIndex == nil { // TODO: deal with a case that the index is not a UNIQUE KEY.
 					ddl := fmt.Sprintf("ALTER TABLE %s ADD UNIQUE KEY %s(%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), g.escapeSQLName(desiredColumn.name))
-					ddls = append(ddls, ddl)
+					ddls = append(ddls, newAlterTableChange(ChangeAddIndex, desired.table.name, desiredColumn.name, ddl))
 				}
 			case GeneratorModePostgres:
 				if !g.haveSameDataType(*currentColumn, desiredColumn) {
 					// Change type
 					ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), g.generateDataType(desiredColumn))
-					ddls = append(ddls, ddl)
+					ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, desired.table.name, currentColumn.name, ddl))
 				}
 
 				if !isPrimaryKey(*currentColumn, currentTable) { // Primary Key implies NOT NULL
 					if g.notNull(*currentColumn) && !g.notNull(desiredColumn) {
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
+						ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, desired.table.name, currentColumn.name, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name))))
 					} else if !g.notNull(*currentColumn) && g.notNull(desiredColumn) {
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
+						ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, desired.table.name, currentColumn.name, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name))))
 					}
 				}
 
@@ -484,14 +722,14 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 						if desiredColumn.sequence != nil {
 							alter += " (" + generateSequenceClause(desiredColumn.sequence) + ")"
 						}
-						ddls = append(ddls, alter)
+						ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, desired.table.name, desiredColumn.name, alter))
 					} else if desiredColumn.identity == nil {
 						// remove
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP IDENTITY IF EXISTS", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name)))
+						ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, currentTable.name, currentColumn.name, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP IDENTITY IF EXISTS", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name))))
 					} else {
 						// set
 						// not support changing sequence
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET GENERATED %s", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), desiredColumn.identity.behavior))
+						ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, desired.table.name, desiredColumn.name, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET GENERATED %s", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), desiredColumn.identity.behavior)))
 					}
 				}
 
@@ -499,14 +737,14 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				if !g.areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) {
 					if desiredColumn.defaultDef == nil {
 						// drop
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name)))
+						ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, currentTable.name, currentColumn.name, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name))))
 					} else {
 						// set
 						definition, err := g.generateDefaultDefinition(*desiredColumn.defaultDef)
 						if err != nil {
 							return ddls, err
 						}
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name), definition))
+						ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, currentTable.name, currentColumn.name, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name), definition)))
 					}
 				}
 
@@ -527,14 +765,14 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				if !areSameCheckDefinition(currentCheck, desiredColumn.check) { // || currentColumn.checkNoInherit != desiredColumn.checkNoInherit {
 					if currentCheck != nil {
 						ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), constraintName)
-						ddls = append(ddls, ddl)
+						ddls = append(ddls, newAlterTableChange(ChangeDropCheck, desired.table.name, constraintName, ddl))
 					}
 					if desiredColumn.check != nil {
 						ddl := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), constraintName, desiredColumn.check.definition)
 						if desiredColumn.check.noInherit {
 							ddl += " NO INHERIT"
 						}
-						ddls = append(ddls, ddl)
+						ddls = append(ddls, newAlterTableChange(ChangeAddCheck, desired.table.name, constraintName, ddl))
 					}
 				}
 
@@ -547,7 +785,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 						return ddls, err
 					}
 					ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", g.escapeTableName(desired.table.name), definition)
-					ddls = append(ddls, ddl)
+					ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, desired.table.name, currentColumn.name, ddl))
 				}
 
 				if !areSameCheckDefinition(currentColumn.check, desiredColumn.check) {
@@ -556,7 +794,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 					if currentColumn.check != nil {
 						currentConstraintName := currentColumn.check.constraintName
 						ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), currentConstraintName)
-						ddls = append(ddls, ddl)
+						ddls = append(ddls, newAlterTableChange(ChangeDropCheck, desired.table.name, currentConstraintName, ddl))
 					}
 					if desiredColumn.check != nil {
 						desiredConstraintName := desiredColumn.check.constraintName
@@ -568,7 +806,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 							replicationDefinition = " NOT FOR REPLICATION"
 						}
 						ddl := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK%s (%s)", g.escapeTableName(desired.table.name), desiredConstraintName, replicationDefinition, desiredColumn.check.definition)
-						ddls = append(ddls, ddl)
+						ddls = append(ddls, newAlterTableChange(ChangeAddCheck, desired.table.name, desiredConstraintName, ddl))
 					}
 				}
 
@@ -576,14 +814,14 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				if !areSameIdentityDefinition(currentColumn.identity, desiredColumn.identity) {
 					if currentColumn.identity != nil {
 						// remove
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name)))
+						ddls = append(ddls, newAlterTableChange(ChangeDropColumn, currentTable.name, currentColumn.name, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name))))
 					}
 					if desiredColumn.identity != nil {
 						definition, err := g.generateColumnDefinition(desiredColumn, true)
 						if err != nil {
 							return ddls, err
 						}
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), definition))
+						ddls = append(ddls, newAlterTableChange(ChangeAddColumn, desired.table.name, desiredColumn.name, fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), definition)))
 					}
 				}
 
@@ -591,7 +829,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				if !g.areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) {
 					if currentColumn.defaultDef != nil {
 						// drop
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.defaultDef.constraintName)))
+						ddls = append(ddls, newAlterTableChange(ChangeDropConstraint, currentTable.name, currentColumn.defaultDef.constraintName, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.defaultDef.constraintName))))
 					}
 					if desiredColumn.defaultDef != nil {
 						// set
@@ -605,7 +843,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 						} else {
 							ddl = fmt.Sprintf("ALTER TABLE %s ADD %s FOR %s", g.escapeTableName(currentTable.name), definition, g.escapeSQLName(currentColumn.name))
 						}
-						ddls = append(ddls, ddl)
+						ddls = append(ddls, newAlterTableChange(ChangeAddConstraint, currentTable.name, desiredColumn.defaultDef.constraintName, ddl))
 					}
 				}
 			default:
@@ -629,7 +867,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				if err != nil {
 					return ddls, err
 				}
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name), definition))
+				ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, currentTable.name, currentColumn.name, fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name), definition)))
 			}
 		}
 	}
@@ -639,16 +877,16 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 		if currentPrimaryKey != nil {
 			switch g.mode {
 			case GeneratorModeMysql:
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY", g.escapeTableName(desired.table.name)))
+				ddls = append(ddls, newAlterTableChange(ChangeDropPrimaryKey, desired.table.name, currentPrimaryKey.name, fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY", g.escapeTableName(desired.table.name))))
 			case GeneratorModePostgres:
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentPrimaryKey.name)))
+				ddls = append(ddls, newAlterTableChange(ChangeDropPrimaryKey, desired.table.name, currentPrimaryKey.name, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentPrimaryKey.name))))
 			case GeneratorModeMssql:
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentPrimaryKey.name)))
+				ddls = append(ddls, newAlterTableChange(ChangeDropPrimaryKey, desired.table.name, currentPrimaryKey.name, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentPrimaryKey.name))))
 			default:
 			}
 		}
 		if desiredPrimaryKey != nil {
-			ddls = append(ddls, g.generateAddIndex(desired.table.name, *desiredPrimaryKey))
+			ddls = append(ddls, g.generateAddIndexChange(desired.table.name, *desiredPrimaryKey))
 		}
 	}
 
@@ -661,12 +899,12 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 		if currentIndex := findIndexByName(currentTable.indexes, desiredIndex.name); currentIndex != nil {
 			// Drop and add index as needed.
 			if !g.areSameIndexes(*currentIndex, desiredIndex) {
-				ddls = append(ddls, g.generateDropIndex(desired.table.name, desiredIndex.name, desiredIndex.constraint))
-				ddls = append(ddls, g.generateAddIndex(desired.table.name, desiredIndex))
+				ddls = append(ddls, g.generateDropIndexChange(desired.table.name, desiredIndex.name, desiredIndex.constraint))
+				ddls = append(ddls, g.generateAddIndexChange(desired.table.name, desiredIndex))
 			}
 		} else {
 			// Index not found, add index.
-			ddls = append(ddls, g.generateAddIndex(desired.table.name, desiredIndex))
+			ddls = append(ddls, g.generateAddIndexChange(desired.table.name, desiredIndex))
 		}
 	}
 
@@ -679,7 +917,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				if err != nil {
 					return ddls, err
 				}
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(desiredColumn.name), definition))
+				ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, currentTable.name, desiredColumn.name, fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(desiredColumn.name), definition)))
 			}
 		}
 	}
@@ -706,14 +944,17 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				default:
 				}
 				if dropDDL != "" {
-					ddls = append(ddls, dropDDL, fmt.Sprintf("ALTER TABLE %s ADD %s%s", g.escapeTableName(desired.table.name), g.generateForeignKeyDefinition(desiredForeignKey), g.generateConstraintOptions(desiredForeignKey.constraintOptions)))
+					ddls = append(ddls,
+						newAlterTableChange(ChangeDropForeignKey, desired.table.name, currentForeignKey.constraintName, dropDDL),
+						newAlterTableChange(ChangeAddForeignKey, desired.table.name, desiredForeignKey.constraintName, fmt.Sprintf("ALTER TABLE %s ADD %s%s", g.escapeTableName(desired.table.name), g.generateForeignKeyDefinition(desiredForeignKey), g.generateConstraintOptions(desiredForeignKey.constraintOptions))),
+					)
 				}
 			}
 		} else {
 			// Foreign key not found, add foreign key.
 			definition := g.generateForeignKeyDefinition(desiredForeignKey)
 			ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), definition)
-			ddls = append(ddls, ddl)
+			ddls = append(ddls, newAlterTableChange(ChangeAddForeignKey, desired.table.name, desiredForeignKey.constraintName, ddl))
 		}
 	}
 
@@ -732,14 +973,17 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 			if !g.areSameExclusions(*currentExclusion, desiredExclusion) {
 				dropDDL := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentExclusion.constraintName))
 				if dropDDL != "" {
-					ddls = append(ddls, dropDDL, fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateExclusionDefinition(desiredExclusion)))
+					ddls = append(ddls,
+						newAlterTableChange(ChangeDropExclusion, desired.table.name, currentExclusion.constraintName, dropDDL),
+						newAlterTableChange(ChangeAddExclusion, desired.table.name, desiredExclusion.constraintName, fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateExclusionDefinition(desiredExclusion))),
+					)
 				}
 			}
 		} else {
 			// Exclusion not found, add exclusion.
 			definition := g.generateExclusionDefinition(desiredExclusion)
 			ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), definition)
-			ddls = append(ddls, ddl)
+			ddls = append(ddls, newAlterTableChange(ChangeAddExclusion, desired.table.name, desiredExclusion.constraintName, ddl))
 		}
 	}
 
@@ -749,32 +993,230 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 			if !areSameCheckDefinition(currentCheck, &desiredCheck) {
 				switch g.mode {
 				case GeneratorModePostgres:
-					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentCheck.constraintName)))
-					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredCheck.constraintName), desiredCheck.definition))
+					ddls = append(ddls, newAlterTableChange(ChangeDropCheck, desired.table.name, currentCheck.constraintName, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentCheck.constraintName))))
+					ddls = append(ddls, newAlterTableChange(ChangeAddCheck, desired.table.name, desiredCheck.constraintName, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredCheck.constraintName), desiredCheck.definition)))
 				default:
 				}
 			}
 		} else {
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredCheck.constraintName), desiredCheck.definition))
+			ddls = append(ddls, newAlterTableChange(ChangeAddCheck, desired.table.name, desiredCheck.constraintName, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredCheck.constraintName), desiredCheck.definition)))
 		}
 	}
 
 	// Examine table comment
 	if currentTable.options["comment"] != desired.table.options["comment"] {
 		if desired.table.options["comment"] == "" {
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s COMMENT = ''", g.escapeTableName(desired.table.name)))
+			ddls = append(ddls, newAlterTableChange(ChangeAlterTableOptions, desired.table.name, desired.table.name, fmt.Sprintf("ALTER TABLE %s COMMENT = ''", g.escapeTableName(desired.table.name))))
 		} else {
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s COMMENT = %s", g.escapeTableName(desired.table.name), desired.table.options["comment"]))
+			ddls = append(ddls, newAlterTableChange(ChangeAlterTableOptions, desired.table.name, desired.table.name, fmt.Sprintf("ALTER TABLE %s COMMENT = %s", g.escapeTableName(desired.table.name), desired.table.options["comment"])))
+		}
+	}
+
+	// Examine table options
+	if g.mode == GeneratorModeMysql {
+		if clauses := g.generateTableOptionClauses(currentTable.options, desired.table.options); len(clauses) > 0 {
+			ddls = append(ddls, newAlterTableChange(ChangeAlterTableOptions, desired.table.name, desired.table.name, fmt.Sprintf("ALTER TABLE %s %s", g.escapeTableName(desired.table.name), strings.Join(clauses, ", "))))
+		}
+	}
+
+	// Examine partitions
+	if g.mode == GeneratorModeMysql {
+		partitionDDLs, err := g.generateDDLsForMysqlPartitions(currentTable, desired.table)
+		if err != nil {
+			return ddls, err
 		}
+		ddls = append(ddls, partitionDDLs...)
 	}
 
 	return ddls, nil
 }
 
+// Add, drop or reorganize the partitions of a MySQL table. Changing the partitioning type or expression rebuilds the table,
+// so it's refused unless allow_repartition is enabled.
+func (g *Generator) generateDDLsForMysqlPartitions(currentTable Table, desired Table) ([]Change, error) {
+	current, desiredPartition := currentTable.partition, desired.partition
+	tableName := g.escapeTableName(desired.name)
+
+	if current == nil && desiredPartition == nil {
+		return nil, nil
+	} else if desiredPartition == nil || current == nil || current.Scheme != desiredPartition.Scheme {
+		if !g.allowRepartition {
+			return nil, fmt.Errorf("changing the partitioning type or expression of table %s rebuilds the table, which requires allow_repartition in the config", desired.name)
+		}
+		ddl := fmt.Sprintf("ALTER TABLE %s REMOVE PARTITIONING", tableName)
+		if desiredPartition != nil {
+			ddl = fmt.Sprintf("ALTER TABLE %s %s", tableName, desiredPartition.Clause)
+		}
+		return []Change{newChange(ChangeRepartition, desired.name, desired.name, ddl)}, nil
+	}
+
+	switch desiredPartition.Type {
+	case "HASH", "KEY":
+		currentCount, desiredCount := max(current.Partitions, 1), max(desiredPartition.Partitions, 1)
+		if desiredCount > currentCount {
+			ddl := fmt.Sprintf("ALTER TABLE %s ADD PARTITION PARTITIONS %d", tableName, desiredCount-currentCount)
+			return []Change{newChange(ChangeAddPartition, desired.name, "", ddl)}, nil
+		} else if desiredCount < currentCount {
+			ddl := fmt.Sprintf("ALTER TABLE %s COALESCE PARTITION %d", tableName, currentCount-desiredCount)
+			return []Change{newChange(ChangeAlterPartition, desired.name, "", ddl)}, nil
+		}
+		return nil, nil
+	case "LIST":
+		return g.generateDDLsForListPartitions(desired.name, current.Definitions, desiredPartition.Definitions), nil
+	default:
+		return g.generateDDLsForRangePartitions(desired.name, current.Definitions, desiredPartition.Definitions), nil
+	}
+}
+
+// Partitions of RANGE are ordered by their upper bounds. Unchanged partitions are kept, and the ones between them are
+// dropped, added at the end or reorganized. Reorganizing includes the next kept partition so that the total range isn't changed.
+func (g *Generator) generateDDLsForRangePartitions(table string, current []*parser.PartitionDefinition, desired []*parser.PartitionDefinition) []Change {
+	ddls := []Change{}
+
+	currentStart, desiredStart := 0, 0
+	for desiredEnd := 0; desiredEnd <= len(desired); desiredEnd++ {
+		currentEnd := len(current)
+		if desiredEnd < len(desired) {
+			currentEnd = findPartitionIndex(current[currentStart:], desired[desiredEnd])
+			if currentEnd < 0 {
+				continue // not kept
+			}
+			currentEnd += currentStart
+		}
+		currentGap, desiredGap := current[currentStart:currentEnd], desired[desiredStart:desiredEnd]
+
+		if len(desiredGap) == 0 {
+			for _, partition := range currentGap {
+				ddls = append(ddls, g.generateDropPartition(table, partition))
+			}
+		} else if len(currentGap) == 0 && currentEnd == len(current) {
+			ddl := fmt.Sprintf("ALTER TABLE %s ADD PARTITION (%s)", g.escapeTableName(table), partitionDefinitions(desiredGap))
+			ddls = append(ddls, newChange(ChangeAddPartition, table, partitionNames(desiredGap), ddl))
+		} else {
+			if currentEnd < len(current) {
+				currentGap, desiredGap = current[currentStart:currentEnd+1], desired[desiredStart:desiredEnd+1]
+			}
+			ddls = append(ddls, g.generateReorganizePartition(table, currentGap, desiredGap))
+		}
+		currentStart, desiredStart = currentEnd+1, desiredEnd+1
+	}
+	return ddls
+}
+
+// Partitions of LIST are not ordered. Partitions whose values are changed are reorganized together
+// since a value may be moved from one to another.
+func (g *Generator) generateDDLsForListPartitions(table string, current []*parser.PartitionDefinition, desired []*parser.PartitionDefinition) []Change {
+	ddls := []Change{}
+
+	var changedCurrent, changedDesired, added []*parser.PartitionDefinition
+	for _, partition := range current {
+		desiredPartition := findPartitionByName(desired, partition.Name.String())
+		if desiredPartition == nil {
+			ddls = append(ddls, g.generateDropPartition(table, partition))
+		} else if desiredPartition.Values != partition.Values {
+			changedCurrent = append(changedCurrent, partition)
+			changedDesired = append(changedDesired, desiredPartition)
+		}
+	}
+	for _, partition := range desired {
+		if findPartitionByName(current, partition.Name.String()) == nil {
+			added = append(added, partition)
+		}
+	}
+
+	if len(changedCurrent) > 0 {
+		ddls = append(ddls, g.generateReorganizePartition(table, changedCurrent, changedDesired))
+	}
+	if len(added) > 0 {
+		ddl := fmt.Sprintf("ALTER TABLE %s ADD PARTITION (%s)", g.escapeTableName(table), partitionDefinitions(added))
+		ddls = append(ddls, newChange(ChangeAddPartition, table, partitionNames(added), ddl))
+	}
+	return ddls
+}
+
+func (g *Generator) generateDropPartition(table string, partition *parser.PartitionDefinition) Change {
+	ddl := fmt.Sprintf("ALTER TABLE %s DROP PARTITION %s", g.escapeTableName(table), g.escapeSQLName(partition.Name.String()))
+	return newChange(ChangeDropPartition, table, partition.Name.String(), ddl)
+}
+
+func (g *Generator) generateReorganizePartition(table string, current []*parser.PartitionDefinition, desired []*parser.PartitionDefinition) Change {
+	var names []string
+	for _, partition := range current {
+		names = append(names, g.escapeSQLName(partition.Name.String()))
+	}
+	ddl := fmt.Sprintf("ALTER TABLE %s REORGANIZE PARTITION %s INTO (%s)", g.escapeTableName(table), strings.Join(names, ", "), partitionDefinitions(desired))
+	return newChange(ChangeAlterPartition, table, partitionNames(current), ddl)
+}
+
+// MySQL table options compared by mysqldef in the order of ALTER TABLE. COMMENT is examined separately.
+var mysqlTableOptions = []struct {
+	name   string // normalized by normalizeTableOptions
+	clause string
+}{
+	{"engine", "ENGINE"},
+	{"charset", "DEFAULT CHARSET"},
+	{"collate", "COLLATE"},
+	{"row_format", "ROW_FORMAT"},
+	{"auto_increment", "AUTO_INCREMENT"},
+}
+
+// Return the clauses of ALTER TABLE to change the table options of current to desired.
+// Only options specified in desired are compared since the dumped table has the default ones of the server.
+func (g *Generator) generateTableOptionClauses(current map[string]string, desired map[string]string) []string {
+	currentOptions := normalizeTableOptions(current)
+	desiredOptions := normalizeTableOptions(desired)
+
+	var clauses []string
+	charsetChanged := false
+	for _, option := range mysqlTableOptions {
+		if option.name == "auto_increment" && !g.manageAutoIncrement {
+			continue // The counter is changed by inserts
+		}
+		desiredValue, ok := desiredOptions[option.name]
+		if !ok {
+			continue
+		}
+		currentValue, ok := currentOptions[option.name]
+		if option.name == "collate" && !ok && !charsetChanged {
+			continue // The default collation of an unchanged charset is not dumped by some versions
+		}
+		if normalizeTableOptionValue(currentValue) != normalizeTableOptionValue(desiredValue) {
+			clauses = append(clauses, fmt.Sprintf("%s=%s", option.clause, desiredValue))
+			charsetChanged = charsetChanged || option.name == "charset"
+		}
+	}
+	return clauses
+}
+
+// utf8 is dumped as utf8mb3 since MySQL 8.0.30
+func normalizeTableOptionValue(value string) string {
+	value = strings.ToLower(value)
+	if value == "utf8" || strings.HasPrefix(value, "utf8_") {
+		value = "utf8mb3" + strings.TrimPrefix(value, "utf8")
+	}
+	return value
+}
+
+// Normalize the names of table options, e.g. "charset" for "DEFAULT CHARSET" and "CHARACTER SET"
+func normalizeTableOptions(options map[string]string) map[string]string {
+	normalized := map[string]string{}
+	for name, value := range options {
+		name = strings.TrimPrefix(strings.ToLower(name), "default ")
+		if name == "character set" {
+			name = "charset"
+		}
+		normalized[name] = value
+	}
+	return normalized
+}
+
 // Shared by `CREATE INDEX` and `ALTER TABLE ADD INDEX`.
 // This manages `g.currentTables` unlike `generateDDLsForCreateTable`...
-func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex Index, action string, statement string) ([]string, error) {
-	ddls := []string{}
+func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex Index, action string, statement string) ([]Change, error) {
+	ddls := []Change{}
+	change := newChange(indexChangeKind(desiredIndex), tableName, desiredIndex.name, statement)
+	change.alterTable = action == "ALTER TABLE"
+	change.Transactional = !desiredIndex.concurrently
 
 	currentTable := findTableByName(g.currentTables, tableName)
 	if currentTable == nil { // Views
@@ -782,8 +1224,18 @@ func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex In
 		currentIndex := findIndexByName(currentView.indexes, desiredIndex.name)
 		if currentIndex == nil {
 			// Index not found, add index.
-			ddls = append(ddls, statement)
+			ddls = append(ddls, change)
 			currentView.indexes = append(currentView.indexes, desiredIndex)
+		} else if !g.areSameIndexes(*currentIndex, desiredIndex) {
+			// Index found. If it's different, drop and add index.
+			ddls = append(ddls, g.generateDropIndexChange(currentView.name, currentIndex.name, currentIndex.constraint))
+			ddls = append(ddls, change)
+			*currentIndex = desiredIndex
+		}
+
+		// Examine indexes in desiredView to delete obsoleted indexes later
+		if desiredView := findViewByName(g.desiredViews, tableName); desiredView != nil {
+			desiredView.indexes = append(desiredView.indexes, desiredIndex)
 		}
 		return ddls, nil
 	}
@@ -791,13 +1243,13 @@ func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex In
 	currentIndex := findIndexByName(currentTable.indexes, desiredIndex.name)
 	if currentIndex == nil {
 		// Index not found, add index.
-		ddls = append(ddls, statement)
+		ddls = append(ddls, change)
 		currentTable.indexes = append(currentTable.indexes, desiredIndex)
 	} else {
 		// Index found. If it's different, drop and add index.
 		if !g.areSameIndexes(*currentIndex, desiredIndex) {
-			ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name, currentIndex.constraint))
-			ddls = append(ddls, statement)
+			ddls = append(ddls, g.generateDropIndexChange(currentTable.name, currentIndex.name, currentIndex.constraint))
+			ddls = append(ddls, change)
 
 			newIndexes := []Index{}
 			for _, currentIndex := range currentTable.indexes {
@@ -817,20 +1269,21 @@ func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex In
 	return ddls, nil
 }
 
-func (g *Generator) generateDDLsForAddForeignKey(tableName string, desiredForeignKey ForeignKey, action string, statement string) ([]string, error) {
-	var ddls []string
+func (g *Generator) generateDDLsForAddForeignKey(tableName string, desiredForeignKey ForeignKey, action string, statement string) ([]Change, error) {
+	var ddls []Change
+	change := newAlterTableChange(ChangeAddForeignKey, tableName, desiredForeignKey.constraintName, statement)
 
 	currentTable := findTableByName(g.currentTables, tableName)
 	currentForeignKey := findForeignKeyByName(currentTable.foreignKeys, desiredForeignKey.constraintName)
 	if currentForeignKey == nil {
 		// Foreign Key not found, add foreign key
-		ddls = append(ddls, statement)
+		ddls = append(ddls, change)
 		currentTable.foreignKeys = append(currentTable.foreignKeys, desiredForeignKey)
 	} else {
 		// Foreign key found, If it's different, drop and add or alter foreign key.
 		if !g.areSameForeignKeys(*currentForeignKey, desiredForeignKey) {
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName)))
-			ddls = append(ddls, statement)
+			ddls = append(ddls, newAlterTableChange(ChangeDropForeignKey, currentTable.name, currentForeignKey.constraintName, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName))))
+			ddls = append(ddls, change)
 		}
 	}
 
@@ -844,20 +1297,21 @@ func (g *Generator) generateDDLsForAddForeignKey(tableName string, desiredForeig
 	return ddls, nil
 }
 
-func (g *Generator) generateDDLsForAddExclusion(tableName string, desiredExclusion Exclusion, action string, statement string) ([]string, error) {
-	var ddls []string
+func (g *Generator) generateDDLsForAddExclusion(tableName string, desiredExclusion Exclusion, action string, statement string) ([]Change, error) {
+	var ddls []Change
+	change := newAlterTableChange(ChangeAddExclusion, tableName, desiredExclusion.constraintName, statement)
 
 	currentTable := findTableByName(g.currentTables, tableName)
 	currentExclusion := findExclusionByName(currentTable.exclusions, desiredExclusion.constraintName)
 	if currentExclusion == nil {
 		// Exclusion not found, add exclusion
-		ddls = append(ddls, statement)
+		ddls = append(ddls, change)
 		currentTable.exclusions = append(currentTable.exclusions, desiredExclusion)
 	} else {
 		// Exclusion key found, If it's different, drop and add or alter exclusion.
 		if !g.areSameExclusions(*currentExclusion, desiredExclusion) {
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentExclusion.constraintName)))
-			ddls = append(ddls, statement)
+			ddls = append(ddls, newAlterTableChange(ChangeDropExclusion, currentTable.name, currentExclusion.constraintName, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentExclusion.constraintName))))
+			ddls = append(ddls, change)
 		}
 	}
 
@@ -871,8 +1325,9 @@ func (g *Generator) generateDDLsForAddExclusion(tableName string, desiredExclusi
 	return ddls, nil
 }
 
-func (g *Generator) generateDDLsForCreatePolicy(tableName string, desiredPolicy Policy, action string, statement string) ([]string, error) {
-	var ddls []string
+func (g *Generator) generateDDLsForCreatePolicy(tableName string, desiredPolicy Policy, action string, statement string) ([]Change, error) {
+	var ddls []Change
+	change := newChange(ChangeCreatePolicy, tableName, desiredPolicy.name, statement)
 
 	currentTable := findTableByName(g.currentTables, tableName)
 	if currentTable == nil {
@@ -882,13 +1337,18 @@ func (g *Generator) generateDDLsForCreatePolicy(tableName string, desiredPolicy
 	currentPolicy := findPolicyByName(currentTable.policies, desiredPolicy.name)
 	if currentPolicy == nil {
 		// Policy not found, add policy.
-		ddls = append(ddls, statement)
+		ddls = append(ddls, change)
 		currentTable.policies = append(currentTable.policies, desiredPolicy)
 	} else {
 		// policy found. If it's different, drop and add or alter policy.
 		if !areSamePolicies(*currentPolicy, desiredPolicy) {
-			ddls = append(ddls, fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(currentPolicy.name), g.escapeTableName(currentTable.name)))
-			ddls = append(ddls, statement)
+			if clauses := generateAlterPolicyClauses(*currentPolicy, desiredPolicy, statement); clauses != nil {
+				ddl := fmt.Sprintf("ALTER POLICY %s ON %s %s", g.escapeSQLName(desiredPolicy.name), g.escapeTableName(currentTable.name), strings.Join(clauses, " "))
+				ddls = append(ddls, newChange(ChangeAlterPolicy, currentTable.name, desiredPolicy.name, ddl))
+			} else {
+				ddls = append(ddls, newChange(ChangeDropPolicy, currentTable.name, currentPolicy.name, fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(currentPolicy.name), g.escapeTableName(currentTable.name))))
+				ddls = append(ddls, change)
+			}
 		}
 	}
 
@@ -905,6 +1365,86 @@ func (g *Generator) generateDDLsForCreatePolicy(tableName string, desiredPolicy
 	return ddls, nil
 }
 
+// Return the clauses of ALTER POLICY to change the roles and the expressions of current to desired.
+// The expressions are copied from the desired statement because the parsed ones are normalized for comparison.
+// This returns nil if ALTER POLICY cannot do it, i.e. when its command or type is changed, or its expression is removed.
+func generateAlterPolicyClauses(current Policy, desired Policy, statement string) []string {
+	if strings.ToLower(current.scope) != strings.ToLower(desired.scope) || strings.ToLower(current.permissive) != strings.ToLower(desired.permissive) {
+		return nil
+	}
+
+	// Compare each of roles and expressions by changing only it
+	var clauses []string
+	withRoles := current
+	withRoles.roles = desired.roles
+	if !areSamePolicies(current, withRoles) {
+		clauses = append(clauses, "TO "+strings.Join(desired.roles, ", "))
+	}
+	withUsing := current
+	withUsing.using = desired.using
+	if !areSamePolicies(current, withUsing) {
+		expr := findPolicyExpression(statement, "USING")
+		if desired.using == "" || expr == "" {
+			return nil
+		}
+		clauses = append(clauses, "USING "+expr)
+	}
+	withCheck := current
+	withCheck.withCheck = desired.withCheck
+	if !areSamePolicies(current, withCheck) {
+		expr := findPolicyExpression(statement, "WITH CHECK")
+		if desired.withCheck == "" || expr == "" {
+			return nil
+		}
+		clauses = append(clauses, "WITH CHECK "+expr)
+	}
+	return clauses
+}
+
+// Find the parenthesized expression following the clause of CREATE POLICY, e.g. "(id = 1)" for "USING".
+// Words inside parentheses or quotes are skipped, and it returns an empty string when the clause is not found.
+func findPolicyExpression(statement string, clause string) string {
+	keywords := strings.Fields(strings.ToUpper(clause))
+	matched := 0
+	depth := 0
+	start := -1
+	for i := 0; i < len(statement); i++ {
+		c := statement[i]
+		switch {
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(statement[i+1:], c)
+			if end < 0 {
+				return ""
+			}
+			i += end + 1
+			matched = 0
+		case c == '(':
+			if depth == 0 && matched == len(keywords) {
+				start = i
+			}
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 && start >= 0 {
+				return statement[start : i+1]
+			}
+			matched = 0
+		case depth == 0 && (unicode.IsLetter(rune(c)) || c == '_'):
+			end := i
+			for end < len(statement) && (unicode.IsLetter(rune(statement[end])) || unicode.IsDigit(rune(statement[end])) || statement[end] == '_') {
+				end++
+			}
+			if matched < len(keywords) && strings.ToUpper(statement[i:end]) == keywords[matched] {
+				matched++
+			} else {
+				matched = 0
+			}
+			i = end - 1
+		}
+	}
+	return ""
+}
+
 func (g *Generator) shouldDropAndCreateView(currentView *View, desiredView *View) bool {
 	if g.mode == GeneratorModeSQLite3 || g.mode == GeneratorModeMssql {
 		return true
@@ -932,154 +1472,886 @@ func (g *Generator) shouldDropAndCreateView(currentView *View, desiredView *View
 	return false
 }
 
-func (g *Generator) generateDDLsForCreateView(viewName string, desiredView *View) ([]string, error) {
-	var ddls []string
+func (g *Generator) generateDDLsForCreateView(viewName string, desiredView *View) ([]Change, error) {
+	var ddls []Change
 
 	currentView := findViewByName(g.currentViews, viewName)
 	if currentView == nil {
 		// View not found, add view.
-		ddls = append(ddls, desiredView.statement)
+		ddls = append(ddls, newChange(ChangeCreateView, viewName, viewName, desiredView.statement))
 		view := *desiredView // copy view
 		g.currentViews = append(g.currentViews, &view)
-	} else if desiredView.viewType == "VIEW" { // TODO: Fix the definition comparison for materialized views and enable this
+	} else if desiredView.viewType == "VIEW" {
 		// View found. If it's different, create or replace view.
 		if g.normalizeViewDefinition(currentView.definition) != g.normalizeViewDefinition(desiredView.definition) {
 			if g.shouldDropAndCreateView(currentView, desiredView) {
-				ddls = append(ddls, fmt.Sprintf("DROP %s %s", desiredView.viewType, g.escapeTableName(viewName)))
-				ddls = append(ddls, fmt.Sprintf("CREATE %s %s AS %s", desiredView.viewType, g.escapeTableName(viewName), desiredView.definition))
+				// Dropping the view to recreate it is not destructive
+				ddls = append(ddls, newChange(ChangeReplaceView, viewName, viewName, fmt.Sprintf("DROP %s %s", desiredView.viewType, g.escapeTableName(viewName))))
+				ddls = append(ddls, newChange(ChangeCreateView, viewName, viewName, fmt.Sprintf("CREATE %s %s AS %s", desiredView.viewType, g.escapeTableName(viewName), desiredView.definition)))
 			} else {
-				ddls = append(ddls, fmt.Sprintf("CREATE OR REPLACE %s %s AS %s", desiredView.viewType, g.escapeTableName(viewName), desiredView.definition))
+				ddls = append(ddls, newChange(ChangeReplaceView, viewName, viewName, fmt.Sprintf("CREATE OR REPLACE %s %s AS %s", desiredView.viewType, g.escapeTableName(viewName), desiredView.definition)))
 			}
 		}
+	} else if desiredView.viewType == "MATERIALIZED VIEW" {
+		// Materialized view found. If it's different, drop and create it. Its indexes are dropped together and created again by CREATE INDEX.
+		// Definitions which are not normalized by the PostgreSQL parser, e.g. `SELECT *` or JOIN, are not compared.
+		if currentView.normalized && desiredView.normalized &&
+			g.normalizeViewDefinition(currentView.definition) != g.normalizeViewDefinition(desiredView.definition) {
+			ddl := fmt.Sprintf("CREATE MATERIALIZED VIEW %s AS %s", g.escapeTableName(viewName), desiredView.definition)
+			if desiredView.withNoData {
+				ddl += " WITH NO DATA"
+			}
+			ddls = append(ddls, newChange(ChangeDropView, viewName, viewName, fmt.Sprintf("DROP MATERIALIZED VIEW %s", g.escapeTableName(viewName))))
+			ddls = append(ddls, newChange(ChangeCreateView, viewName, viewName, ddl))
+			currentView.definition = desiredView.definition
+			currentView.indexes = nil
+		}
 	} else if desiredView.viewType == "SQL SECURITY" {
 		// VIEW with the specified security type found. If it's different, create or replace view.
 		if g.normalizeViewDefinition(currentView.securityType) != g.normalizeViewDefinition(desiredView.securityType) {
-			ddls = append(ddls, fmt.Sprintf("CREATE OR REPLACE SQL SECURITY %s VIEW %s AS %s", desiredView.securityType, g.escapeTableName(viewName), desiredView.definition))
+			ddls = append(ddls, newChange(ChangeReplaceView, viewName, viewName, fmt.Sprintf("CREATE OR REPLACE SQL SECURITY %s VIEW %s AS %s", desiredView.securityType, g.escapeTableName(viewName), desiredView.definition)))
+		}
+	}
+
+	// Examine policies in desiredTable to delete obsoleted policies later
+	if containsString(convertViewNames(g.desiredViews), desiredView.name) {
+		return nil, fmt.Errorf("view '%s' is doubly created: '%s'", desiredView.name, desiredView.statement)
+	}
+	g.desiredViews = append(g.desiredViews, desiredView)
+
+	return ddls, nil
+}
+
+// Workaround for: jsonb_extract_path_text(payload, array['amount'])
+// generated by jsonb_extract_path_text(payload, 'amount')
+// and collate, etc.
+func (g *Generator) normalizeViewDefinition(definition string) string {
+	definition = strings.ToLower(definition)
+	if g.mode == GeneratorModePostgres {
+		definition = strings.ReplaceAll(definition, "array[", "")
+		definition = strings.ReplaceAll(definition, "]", "")
+		definition = strings.ReplaceAll(definition, "collate", "")
+		definition = strings.ReplaceAll(definition, "  ", "")
+	}
+	return definition
+}
+
+func (g *Generator) generateDDLsForCreateTrigger(triggerName string, desiredTrigger *Trigger) ([]Change, error) {
+	var ddls []Change
+	currentTrigger := g.findTrigger(g.currentTriggers, desiredTrigger)
+
+	var triggerDefinition string
+	switch g.mode {
+	case GeneratorModeMssql:
+		triggerDefinition += fmt.Sprintf("TRIGGER %s ON %s %s %s AS\n%s", g.escapeSQLName(desiredTrigger.name), g.escapeTableName(desiredTrigger.tableName), desiredTrigger.time, strings.Join(desiredTrigger.event, ", "), strings.Join(desiredTrigger.body, "\n"))
+	case GeneratorModeMysql:
+		triggerDefinition += fmt.Sprintf("TRIGGER %s %s %s ON %s FOR EACH ROW %s", g.escapeSQLName(desiredTrigger.name), desiredTrigger.time, strings.Join(desiredTrigger.event, ", "), g.escapeTableName(desiredTrigger.tableName), strings.Join(desiredTrigger.body, "\n"))
+	case GeneratorModeSQLite3, GeneratorModePostgres:
+		triggerDefinition = desiredTrigger.statement
+	default:
+		return ddls, nil
+	}
+
+	if currentTrigger == nil {
+		// Trigger not found, add trigger.
+		var createPrefix string
+		if g.mode != GeneratorModeSQLite3 && g.mode != GeneratorModePostgres {
+			createPrefix = "CREATE "
 		}
+		ddls = append(ddls, newChange(ChangeCreateTrigger, desiredTrigger.tableName, triggerName, createPrefix+triggerDefinition))
+	} else {
+		// Trigger found. If it's different, create or replace trigger.
+		if g.mode == GeneratorModePostgres && !currentTrigger.constraint && !desiredTrigger.constraint {
+			// CREATE OR REPLACE is not supported for constraint triggers, which are dropped and created below.
+			if !areSameTriggerDefinition(currentTrigger, desiredTrigger) {
+				ddls = append(ddls, newChange(ChangeReplaceTrigger, desiredTrigger.tableName, triggerName, createOrReplacePattern.ReplaceAllString(triggerDefinition, "CREATE OR REPLACE ")))
+			}
+		} else if !areSameTriggerDefinition(currentTrigger, desiredTrigger) {
+			if g.mode == GeneratorModePostgres {
+				// Dropping a constraint trigger to recreate it is not destructive
+				ddls = append(ddls, newChange(ChangeReplaceTrigger, currentTrigger.tableName, triggerName, g.generateDropTrigger(currentTrigger)))
+			} else if g.mode != GeneratorModeMssql {
+				ddls = append(ddls, newChange(ChangeDropTrigger, currentTrigger.tableName, triggerName, g.generateDropTrigger(currentTrigger)))
+			}
+			var createPrefix string
+			if g.mode == GeneratorModeMssql {
+				createPrefix = "CREATE OR ALTER "
+			} else if g.mode != GeneratorModeSQLite3 && g.mode != GeneratorModePostgres {
+				createPrefix = "CREATE "
+			}
+			ddls = append(ddls, newChange(ChangeReplaceTrigger, desiredTrigger.tableName, triggerName, createPrefix+triggerDefinition))
+		}
+	}
+
+	g.desiredTriggers = append(g.desiredTriggers, desiredTrigger)
+
+	return ddls, nil
+}
+
+func (g *Generator) generateDropTrigger(trigger *Trigger) string {
+	if g.mode == GeneratorModePostgres {
+		return fmt.Sprintf("DROP TRIGGER %s ON %s", g.escapeSQLName(trigger.name), g.escapeTableName(trigger.tableName))
+	}
+	return fmt.Sprintf("DROP TRIGGER %s", g.escapeSQLName(trigger.name))
+}
+
+// Find a trigger with the name of the given trigger. PostgreSQL triggers are unique only within their table.
+func (g *Generator) findTrigger(triggers []*Trigger, trigger *Trigger) *Trigger {
+	for _, t := range triggers {
+		if t.name == trigger.name && (g.mode != GeneratorModePostgres || t.tableName == trigger.tableName) {
+			return t
+		}
+	}
+	return nil
+}
+
+func (g *Generator) generateDDLsForCreateType(desired *Type, desiredDDLs []DDL) ([]Change, error) {
+	ddls := []Change{}
+
+	if currentType := findTypeByName(g.currentTypes, desired.name); currentType != nil {
+		// Type found. Rename and add values.
+		if currentType.enumValues != nil {
+			enumDDLs, err := g.generateDDLsForEnumValues(currentType, desired, desiredDDLs)
+			if err != nil {
+				return nil, err
+			}
+			ddls = append(ddls, enumDDLs...)
+		}
+		ddls = append(ddls, g.generateDDLsForTypeAttributes(currentType, desired)...)
+	} else {
+		// Type not found, add type.
+		ddls = append(ddls, newChange(ChangeCreateType, "", desired.name, desired.statement))
+	}
+	g.desiredTypes = append(g.desiredTypes, desired)
+
+	return ddls, nil
+}
+
+// Rename values annotated with `@renamed from`, and add new values at their positions.
+// Since ALTER TYPE cannot remove or reorder values, that requires recreating the type, which is enabled by recreateEnums.
+func (g *Generator) generateDDLsForEnumValues(currentType *Type, desired *Type, desiredDDLs []DDL) ([]Change, error) {
+	ddls := []Change{}
+	currentValues := slices.Clone(currentType.enumValues)
+
+	for _, desiredValue := range desired.enumValues {
+		renamedFrom, ok := desired.renamedValues[desiredValue]
+		if !ok || containsString(currentValues, desiredValue) || containsString(desired.enumValues, renamedFrom) {
+			continue
+		}
+		if i := slices.Index(currentValues, renamedFrom); i >= 0 {
+			ddl := fmt.Sprintf("ALTER TYPE %s RENAME VALUE %s TO %s", g.escapeTableName(currentType.name), renamedFrom, desiredValue)
+			ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, ddl))
+			currentValues[i] = desiredValue
+		}
+	}
+
+	// Values in both types must be in the same order
+	var keptValues, orderedValues []string
+	for _, value := range currentValues {
+		if containsString(desired.enumValues, value) {
+			keptValues = append(keptValues, value)
+		}
+	}
+	for _, value := range desired.enumValues {
+		if containsString(currentValues, value) {
+			orderedValues = append(orderedValues, value)
+		}
+	}
+	if len(keptValues) < len(currentValues) || !slices.Equal(keptValues, orderedValues) {
+		if !g.recreateEnums {
+			return nil, fmt.Errorf("removing or reordering values of enum type %s requires recreating it, which is enabled by recreate_enums in --config: %s", desired.name, desired.statement)
+		}
+		return append(ddls, g.generateDDLsForRecreateEnum(desired, desiredDDLs)...), nil
+	}
+
+	for i, desiredValue := range desired.enumValues {
+		if containsString(currentValues, desiredValue) {
+			continue
+		}
+		ddl := fmt.Sprintf("ALTER TYPE %s ADD VALUE %s", g.escapeTableName(currentType.name), desiredValue)
+		// A value is appended unless it's followed by an existing value
+		for _, nextValue := range desired.enumValues[i+1:] {
+			if containsString(currentValues, nextValue) {
+				ddl += " BEFORE " + nextValue
+				break
+			}
+		}
+		// A new value cannot be used in the transaction adding it, e.g. by a default of a column
+		change := newChange(ChangeAlterType, "", desired.name, ddl)
+		change.Transactional = false
+		ddls = append(ddls, change)
+	}
+	return ddls, nil
+}
+
+// Create a new enum type with the desired values, cast columns of the current type to it, and swap the types.
+// The casts fail if the columns have values removed from the type.
+func (g *Generator) generateDDLsForRecreateEnum(desired *Type, desiredDDLs []DDL) []Change {
+	ddls := []Change{}
+	typeSchema, typeName := splitTableName(desired.name, g.defaultSchema)
+	newTypeName := fmt.Sprintf("%s.%s_new", typeSchema, typeName)
+	ddl := fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", g.escapeTableName(newTypeName), strings.Join(desired.enumValues, ", "))
+	ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, ddl))
+
+	var defaultDDLs []Change
+	for _, table := range g.currentTables {
+		columns := make([]*Column, len(table.columns))
+		for _, column := range table.columns {
+			columns[column.position] = column
+		}
+		for _, column := range columns {
+			if column == nil || column.typeName != typeName || column.references != typeSchema+"." && (column.references != "" || typeSchema != g.defaultSchema) {
+				continue
+			}
+			tableName, columnName := g.escapeTableName(table.name), g.escapeSQLName(column.name)
+
+			// A default of the current type cannot be cast to the new type. It's restored unless the desired table
+			// changes it, which is done when the table is examined.
+			if column.defaultDef != nil {
+				ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", tableName, columnName)
+				ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, table.name, column.name, ddl))
+				if desiredColumn := findDesiredColumn(desiredDDLs, table.name, column.name); desiredColumn != nil && !g.areSameDefaultValue(column.defaultDef, desiredColumn.defaultDef) {
+					column.defaultDef = nil
+				} else if definition, err := g.generateDefaultDefinition(*column.defaultDef); err == nil {
+					ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET %s", tableName, columnName, definition)
+					defaultDDLs = append(defaultDDLs, newAlterTableChange(ChangeAlterColumn, table.name, column.name, ddl))
+				}
+			}
+
+			textType, newType := "text", g.escapeTableName(newTypeName)
+			if column.array {
+				textType, newType = textType+"[]", newType+"[]"
+			}
+			ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s USING %s::%s::%s", tableName, columnName, newType, columnName, textType, newType)
+			ddls = append(ddls, newAlterTableChange(ChangeAlterColumn, table.name, column.name, ddl))
+		}
+	}
+
+	ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, fmt.Sprintf("DROP TYPE %s", g.escapeTableName(desired.name))))
+	ddl = fmt.Sprintf("ALTER TYPE %s RENAME TO %s", g.escapeTableName(newTypeName), g.escapeSQLName(typeName))
+	ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, ddl))
+	return append(ddls, defaultDDLs...)
+}
+
+// Attach or detach a partition when its parent or bound is changed. The partition key of a table cannot be changed.
+func (g *Generator) generateDDLsForPartition(currentTable *Table, desired *Table) ([]Change, error) {
+	ddls := []Change{}
+	if currentTable.partitionBy != desired.partitionBy {
+		return nil, fmt.Errorf("changing the partition key of table %s is not supported: '%s' -> '%s'", desired.name, currentTable.partitionBy, desired.partitionBy)
+	}
+	if areSamePartitionOf(currentTable.partitionOf, desired.partitionOf) {
+		return ddls, nil
+	}
+
+	if current := currentTable.partitionOf; current != nil {
+		ddl := fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", g.escapeTableName(current.parent), g.escapeTableName(currentTable.name))
+		ddls = append(ddls, newChange(ChangeDetachPartition, current.parent, currentTable.name, ddl))
+
+		// A detached partition keeps the columns of its parent
+		if parent := findTableByName(g.currentTables, current.parent); parent != nil && len(currentTable.columns) == 0 {
+			currentTable.columns = map[string]*Column{}
+			for name, column := range parent.columns {
+				column := *column
+				currentTable.columns[name] = &column
+			}
+		}
+		currentTable.partitionOf = nil
+	}
+	if partitionOf := desired.partitionOf; partitionOf != nil {
+		ddl := fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s %s", g.escapeTableName(partitionOf.parent), g.escapeTableName(desired.name), partitionOf.bound)
+		ddls = append(ddls, newChange(ChangeAttachPartition, partitionOf.parent, desired.name, ddl))
+	}
+	return ddls, nil
+}
+
+// Alter attributes of a composite type. Attributes are handled like columns of the type so that
+// dropping them requires enable_drop of columns.
+func (g *Generator) generateDDLsForTypeAttributes(currentType *Type, desired *Type) []Change {
+	ddls := []Change{}
+	for _, desiredAttribute := range desired.attributes {
+		currentAttribute := findAttributeByName(currentType.attributes, desiredAttribute.name)
+		if currentAttribute == nil {
+			ddl := fmt.Sprintf("ALTER TYPE %s ADD ATTRIBUTE %s %s", g.escapeTableName(desired.name), g.escapeSQLName(desiredAttribute.name), g.generateDataType(desiredAttribute))
+			ddls = append(ddls, newChange(ChangeAddAttribute, desired.name, desiredAttribute.name, ddl))
+		} else if !g.haveSameDataType(*currentAttribute, desiredAttribute) {
+			ddl := fmt.Sprintf("ALTER TYPE %s ALTER ATTRIBUTE %s TYPE %s", g.escapeTableName(desired.name), g.escapeSQLName(desiredAttribute.name), g.generateDataType(desiredAttribute))
+			ddls = append(ddls, newChange(ChangeAlterAttribute, desired.name, desiredAttribute.name, ddl))
+		}
+	}
+	for _, currentAttribute := range currentType.attributes {
+		if findAttributeByName(desired.attributes, currentAttribute.name) == nil {
+			ddl := fmt.Sprintf("ALTER TYPE %s DROP ATTRIBUTE %s", g.escapeTableName(desired.name), g.escapeSQLName(currentAttribute.name))
+			ddls = append(ddls, newChange(ChangeDropAttribute, desired.name, currentAttribute.name, ddl))
+		}
+	}
+	return ddls
+}
+
+func (g *Generator) generateDDLsForCreateDomain(desired *Domain) ([]Change, error) {
+	ddls := []Change{}
+
+	current := findDomainByName(g.currentDomains, desired.name)
+	if current == nil {
+		ddls = append(ddls, newChange(ChangeCreateType, "", desired.name, desired.statement))
+		g.desiredDomains = append(g.desiredDomains, desired)
+		return ddls, nil
+	}
+
+	domainName := g.escapeTableName(desired.name)
+	if !g.haveSameDataType(current.column, desired.column) {
+		return nil, fmt.Errorf("changing the base type of domain %s is not supported: %s", desired.name, desired.statement)
+	}
+
+	if !g.areSameDefaultValue(current.column.defaultDef, desired.column.defaultDef) {
+		if desired.column.defaultDef == nil {
+			ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, fmt.Sprintf("ALTER DOMAIN %s DROP DEFAULT", domainName)))
+		} else {
+			definition, err := g.generateDefaultDefinition(*desired.column.defaultDef)
+			if err != nil {
+				return nil, err
+			}
+			ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, fmt.Sprintf("ALTER DOMAIN %s SET %s", domainName, definition)))
+		}
+	}
+
+	if g.notNull(current.column) != g.notNull(desired.column) {
+		if g.notNull(desired.column) {
+			ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, fmt.Sprintf("ALTER DOMAIN %s SET NOT NULL", domainName)))
+		} else {
+			ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, fmt.Sprintf("ALTER DOMAIN %s DROP NOT NULL", domainName)))
+		}
+	}
+
+	for _, currentCheck := range current.checks {
+		desiredCheck := findCheckByName(desired.checks, currentCheck.constraintName)
+		if desiredCheck == nil || !areSameCheckDefinition(&currentCheck, desiredCheck) {
+			ddl := fmt.Sprintf("ALTER DOMAIN %s DROP CONSTRAINT %s", domainName, g.escapeSQLName(currentCheck.constraintName))
+			ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, ddl))
+		}
+	}
+	for _, desiredCheck := range desired.checks {
+		currentCheck := findCheckByName(current.checks, desiredCheck.constraintName)
+		if currentCheck == nil || !areSameCheckDefinition(currentCheck, &desiredCheck) {
+			ddl := fmt.Sprintf("ALTER DOMAIN %s ADD CONSTRAINT %s CHECK (%s)", domainName, g.escapeSQLName(desiredCheck.constraintName), desiredCheck.definition)
+			ddls = append(ddls, newChange(ChangeAlterType, "", desired.name, ddl))
+		}
+	}
+
+	g.desiredDomains = append(g.desiredDomains, desired)
+	return ddls, nil
+}
+
+func (g *Generator) generateDDLsForComment(desired *Comment) ([]Change, error) {
+	ddls := []Change{}
+
+	currentComment := findCommentByObject(g.currentComments, desired.comment.Object)
+	if currentComment == nil || currentComment.comment.Comment != desired.comment.Comment {
+		// Comment not found, add comment.
+		ddls = append(ddls, newChange(ChangeComment, "", desired.comment.Object, desired.statement))
+	}
+
+	return ddls, nil
+}
+
+func (g *Generator) generateDDLsForExtension(desired *Extension) ([]Change, error) {
+	ddls := []Change{}
+
+	if currentExtension := findExtensionByName(g.currentExtensions, desired.extension.Name); currentExtension == nil {
+		// Extension not found, add extension.
+		ddls = append(ddls, newChange(ChangeCreateExtension, "", desired.extension.Name, desired.statement))
+		extension := *desired // copy extension
+		g.currentExtensions = append(g.currentExtensions, &extension)
+	}
+
+	g.desiredExtensions = append(g.desiredExtensions, desired)
+
+	return ddls, nil
+}
+
+var createOrReplacePattern = regexp.MustCompile(`(?i)^CREATE\s+(OR\s+REPLACE\s+)?`)
+
+func (g *Generator) generateDDLsForFunction(desired *Function) ([]Change, error) {
+	ddls := []Change{}
+
+	currentFunction := g.findFunction(g.currentFunctions, desired.signature(), desired.procedure)
+	if currentFunction == nil {
+		// Function not found, add function.
+		ddls = append(ddls, newChange(ChangeCreateFunction, "", desired.signature(), desired.statement))
+		function := *desired // copy function
+		g.currentFunctions = append(g.currentFunctions, &function)
+	} else if currentFunction.definition != desired.definition {
+		// Function found. If it's different, create or replace function.
+		createStatement := createOrReplacePattern.ReplaceAllString(desired.statement, "CREATE OR REPLACE ")
+		if currentFunction.returns != desired.returns || currentFunction.procedure != desired.procedure || g.mode == GeneratorModeMysql {
+			// CREATE OR REPLACE cannot change the return type, and MySQL doesn't support it. The function is recreated with the same signature.
+			ddls = append(ddls, newChange(ChangeReplaceFunction, "", desired.signature(), g.generateDropFunction(currentFunction)))
+			createStatement = desired.statement
+		}
+		ddls = append(ddls, newChange(ChangeReplaceFunction, "", desired.signature(), createStatement))
+	}
+
+	if g.findFunction(g.desiredFunctions, desired.signature(), desired.procedure) != nil {
+		return nil, fmt.Errorf("function '%s' is doubly created: '%s'", desired.signature(), desired.statement)
+	}
+	g.desiredFunctions = append(g.desiredFunctions, desired)
+
+	return ddls, nil
+}
+
+func (g *Generator) generateDDLsForEvent(desired *Event) ([]Change, error) {
+	ddls := []Change{}
+
+	currentEvent := findEventByName(g.currentEvents, desired.name)
+	if currentEvent == nil {
+		// Event not found, add event.
+		ddls = append(ddls, newChange(ChangeCreateEvent, "", desired.name, desired.statement))
+	} else if currentEvent.definition != desired.definition || (desired.starts != "" && currentEvent.starts != desired.starts) {
+		// Event found. If it's different, recreate event. STARTS is compared only when it's specified since it defaults to the creation time.
+		ddls = append(ddls, newChange(ChangeReplaceEvent, "", desired.name, fmt.Sprintf("DROP EVENT %s", g.escapeTableName(currentEvent.name))))
+		ddls = append(ddls, newChange(ChangeReplaceEvent, "", desired.name, desired.statement))
+	}
+
+	if findEventByName(g.desiredEvents, desired.name) != nil {
+		return nil, fmt.Errorf("event '%s' is doubly created: '%s'", desired.name, desired.statement)
+	}
+	g.desiredEvents = append(g.desiredEvents, desired)
+
+	return ddls, nil
+}
+
+func (g *Generator) generateDDLsForCreateSequence(desired *CreateSequence) ([]Change, error) {
+	ddls := []Change{}
+
+	currentSequence := findSequenceByName(g.currentSequences, desired.name)
+	if currentSequence == nil {
+		// Sequence not found, add sequence.
+		ddls = append(ddls, newChange(ChangeCreateSequence, "", desired.name, desired.statement))
+		sequence := *desired // copy sequence
+		options := *desired.sequence
+		sequence.sequence = &options
+		g.currentSequences = append(g.currentSequences, &sequence)
+	} else if clause := generateAlterSequenceClause(currentSequence.sequence, desired.sequence); clause != "" {
+		// Sequence found. If its options are different, alter them.
+		ddls = append(ddls, newChange(ChangeAlterSequence, "", desired.name, fmt.Sprintf("ALTER SEQUENCE %s %s", g.escapeTableName(desired.name), clause)))
+	}
+
+	if findSequenceByName(g.desiredSequences, desired.name) != nil {
+		return nil, fmt.Errorf("sequence '%s' is doubly created: '%s'", desired.name, desired.statement)
+	}
+	g.desiredSequences = append(g.desiredSequences, desired)
+
+	return ddls, nil
+}
+
+// OWNED BY is compared separately from other options since it may be specified by ALTER SEQUENCE after the table is created.
+func (g *Generator) generateDDLsForSequenceOwners() []Change {
+	ddls := []Change{}
+	for _, desired := range g.desiredSequences {
+		current := findSequenceByName(g.currentSequences, desired.name)
+		if current == nil || current.sequence.OwnedBy == desired.sequence.OwnedBy {
+			continue
+		}
+		owner := "NONE"
+		if desired.sequence.OwnedBy != "" {
+			ownerTable, ownerColumn := splitSequenceOwner(desired.sequence.OwnedBy)
+			owner = g.escapeTableName(ownerTable) + "." + g.escapeSQLName(ownerColumn)
+		}
+		ddls = append(ddls, newChange(ChangeAlterSequence, "", desired.name, fmt.Sprintf("ALTER SEQUENCE %s OWNED BY %s", g.escapeTableName(desired.name), owner)))
+	}
+	return ddls
+}
+
+// Grant privileges of managed roles which are not granted yet. Obsoleted ones are revoked by generateDDLsForRevoke.
+func (g *Generator) generateDDLsForGrant(desired *Grant) []Change {
+	ddls := []Change{}
+	for _, desiredPrivilege := range desired.privileges {
+		if !g.isManagedRole(desiredPrivilege.grantee) {
+			var privileges, grantable []string
+			for _, privilege := range desiredPrivilege.privileges {
+				if desiredPrivilege.hasGrantOption(privilege) {
+					grantable = append(grantable, privilege)
+				} else {
+					privileges = append(privileges, privilege)
+				}
+			}
+			ddls = append(ddls, unmanagedChanges(g.generateGrants(desiredPrivilege, privileges, grantable))...)
+			continue
+		}
+
+		var privileges, grantable []string
+		current := findPrivilege(g.currentPrivileges, desiredPrivilege)
+		for _, privilege := range desiredPrivilege.privileges {
+			withGrantOption := desiredPrivilege.hasGrantOption(privilege)
+			if current != nil && current.hasPrivilege(privilege) && (!withGrantOption || current.hasGrantOption(privilege)) {
+				continue
+			}
+			if withGrantOption {
+				grantable = append(grantable, privilege)
+			} else {
+				privileges = append(privileges, privilege)
+			}
+		}
+		ddls = append(ddls, g.generateGrants(desiredPrivilege, privileges, grantable)...)
+
+		if current == nil {
+			current = &Privilege{objectType: desiredPrivilege.objectType, name: desiredPrivilege.name, arguments: desiredPrivilege.arguments, grantee: desiredPrivilege.grantee}
+			g.currentPrivileges = append(g.currentPrivileges, current)
+		}
+		current.add(privileges, false)
+		current.add(grantable, true)
+	}
+	g.desiredPrivileges = mergePrivileges(g.desiredPrivileges, desired.privileges)
+	return ddls
+}
+
+// Revoke privileges of managed roles which are not in the desired schema
+func (g *Generator) generateDDLsForRevoke() []Change {
+	ddls := []Change{}
+	for _, current := range g.currentPrivileges {
+		if !g.isManagedRole(current.grantee) || g.isPrivilegeTargetDropped(current) {
+			continue
+		}
+		desired := findPrivilege(g.desiredPrivileges, current)
+		if desired == nil {
+			desired = &Privilege{}
+		}
+
+		if containsString(current.privileges, "ALL") && !containsString(desired.privileges, "ALL") {
+			// Revoke ALL and grant the remaining privileges again
+			ddls = append(ddls, g.generateRevoke(current, "", []string{"ALL"}))
+			var privileges, grantable []string
+			for _, privilege := range desired.privileges {
+				if desired.hasGrantOption(privilege) {
+					grantable = append(grantable, privilege)
+				} else {
+					privileges = append(privileges, privilege)
+				}
+			}
+			ddls = append(ddls, g.generateGrants(current, privileges, grantable)...)
+			continue
+		}
+
+		var privileges, grantOptions []string
+		for _, privilege := range current.privileges {
+			if !desired.hasPrivilege(privilege) {
+				privileges = append(privileges, privilege)
+			}
+		}
+		for _, privilege := range current.grantable {
+			if !containsString(privileges, privilege) && !desired.hasGrantOption(privilege) {
+				grantOptions = append(grantOptions, privilege)
+			}
+		}
+		if len(privileges) > 0 {
+			ddls = append(ddls, g.generateRevoke(current, "", privileges))
+		}
+		if len(grantOptions) > 0 {
+			ddls = append(ddls, g.generateRevoke(current, "GRANT OPTION FOR ", grantOptions))
+		}
+	}
+	return ddls
+}
+
+func (g *Generator) generateGrants(target *Privilege, privileges []string, grantable []string) []Change {
+	ddls := []Change{}
+	if len(privileges) > 0 {
+		ddl := fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(privileges, ", "), g.escapePrivilegeObject(target), g.escapeGrantee(target.grantee))
+		ddls = append(ddls, newChange(ChangeGrant, privilegeTable(target), target.grantee, ddl))
+	}
+	if len(grantable) > 0 {
+		ddl := fmt.Sprintf("GRANT %s ON %s TO %s WITH GRANT OPTION", strings.Join(grantable, ", "), g.escapePrivilegeObject(target), g.escapeGrantee(target.grantee))
+		ddls = append(ddls, newChange(ChangeGrant, privilegeTable(target), target.grantee, ddl))
+	}
+	return ddls
+}
+
+func (g *Generator) generateRevoke(target *Privilege, option string, privileges []string) Change {
+	ddl := fmt.Sprintf("REVOKE %s%s ON %s FROM %s", option, strings.Join(privileges, ", "), g.escapePrivilegeObject(target), g.escapeGrantee(target.grantee))
+	return newChange(ChangeRevoke, privilegeTable(target), target.grantee, ddl)
+}
+
+func (g *Generator) escapePrivilegeObject(privilege *Privilege) string {
+	switch privilege.objectType {
+	case "SCHEMA":
+		return "SCHEMA " + g.escapeSQLName(privilege.name)
+	case "FUNCTION", "PROCEDURE":
+		return fmt.Sprintf("%s %s(%s)", privilege.objectType, g.escapeTableName(privilege.name), strings.Join(privilege.arguments, ", "))
+	default:
+		return privilege.objectType + " " + g.escapeTableName(privilege.name)
+	}
+}
+
+func (g *Generator) escapeGrantee(grantee string) string {
+	if grantee == "PUBLIC" {
+		return grantee
+	}
+	return g.escapeSQLName(grantee)
+}
+
+func (g *Generator) isManagedRole(role string) bool {
+	return containsString(g.managedRoles, role)
+}
+
+// Privileges on a dropped object are not revoked since they're dropped with it
+func (g *Generator) isPrivilegeTargetDropped(privilege *Privilege) bool {
+	switch privilege.objectType {
+	case "TABLE":
+		if findTableByName(g.currentTables, privilege.name) != nil {
+			return findTableByName(g.desiredTables, privilege.name) == nil
+		}
+		if findViewByName(g.currentViews, privilege.name) != nil {
+			return findViewByName(g.desiredViews, privilege.name) == nil
+		}
+	case "SEQUENCE":
+		if findSequenceByName(g.currentSequences, privilege.name) != nil {
+			return findSequenceByName(g.desiredSequences, privilege.name) == nil
+		}
+	case "FUNCTION", "PROCEDURE":
+		signature := fmt.Sprintf("%s(%s)", privilege.name, strings.Join(privilege.arguments, ", "))
+		procedure := privilege.objectType == "PROCEDURE"
+		if g.findFunction(g.currentFunctions, signature, procedure) != nil {
+			return g.findFunction(g.desiredFunctions, signature, procedure) == nil
+		}
+	}
+	return false
+}
+
+// Create or alter a managed role. Roles of other names are left alone.
+func (g *Generator) generateDDLsForCreateRole(desired *Role) ([]Change, error) {
+	ddls := []Change{}
+	if !g.isManagedRole(desired.role.Name) {
+		return unmanagedChanges(append(ddls, newChange(ChangeCreateRole, "", desired.role.Name, desired.statement))), nil
+	}
+
+	currentRole := findRoleByName(g.currentRoles, desired.role.Name)
+	if currentRole == nil {
+		ddls = append(ddls, newChange(ChangeCreateRole, "", desired.role.Name, desired.statement))
+		g.currentRoles = append(g.currentRoles, desired)
+	} else if options := roleOptions(currentRole.role, desired.role); len(options) > 0 {
+		ddl := fmt.Sprintf("ALTER ROLE %s WITH %s", g.escapeSQLName(desired.role.Name), strings.Join(options, " "))
+		ddls = append(ddls, newChange(ChangeAlterRole, "", desired.role.Name, ddl))
+	}
+
+	if findRoleByName(g.desiredRoles, desired.role.Name) != nil {
+		return nil, fmt.Errorf("role '%s' is doubly created: '%s'", desired.role.Name, desired.statement)
+	}
+	g.desiredRoles = append(g.desiredRoles, desired)
+	return ddls, nil
+}
+
+// Grant roles to managed members
+func (g *Generator) generateDDLsForGrantRole(desired *GrantRole) []Change {
+	ddls := []Change{}
+	for _, membership := range desired.memberships {
+		ddl := fmt.Sprintf("GRANT %s TO %s", g.escapeSQLName(membership.role), g.escapeSQLName(membership.member))
+		if !g.isManagedRole(membership.member) {
+			ddls = append(ddls, unmanagedChanges([]Change{newChange(ChangeGrant, "", membership.member, ddl)})...)
+			continue
+		}
+		if !slices.Contains(g.currentMemberships, membership) {
+			ddls = append(ddls, newChange(ChangeGrant, "", membership.member, ddl))
+			g.currentMemberships = append(g.currentMemberships, membership)
+		}
+		if !slices.Contains(g.desiredMemberships, membership) {
+			g.desiredMemberships = append(g.desiredMemberships, membership)
+		}
+	}
+	return ddls
+}
+
+// Revoke memberships of managed members which are not in the desired schema. They're left to DROP ROLE when either role is dropped.
+func (g *Generator) generateDDLsForRevokeRole() []Change {
+	ddls := []Change{}
+	for _, membership := range g.currentMemberships {
+		if !g.isManagedRole(membership.member) || slices.Contains(g.desiredMemberships, membership) || g.isRoleDropped(membership.role) || g.isRoleDropped(membership.member) {
+			continue
+		}
+		ddl := fmt.Sprintf("REVOKE %s FROM %s", g.escapeSQLName(membership.role), g.escapeSQLName(membership.member))
+		ddls = append(ddls, newChange(ChangeRevoke, "", membership.member, ddl))
+	}
+	return ddls
+}
+
+func (g *Generator) isRoleDropped(name string) bool {
+	return g.isManagedRole(name) && findRoleByName(g.currentRoles, name) != nil && findRoleByName(g.desiredRoles, name) == nil
+}
+
+// Change the owner of a table to a managed role
+func (g *Generator) generateDDLsForAlterOwner(desired *AlterOwner) ([]Change, error) {
+	ddls := []Change{}
+	currentTable := findTableByName(g.currentTables, desired.tableName)
+	if currentTable == nil {
+		return nil, fmt.Errorf("ALTER TABLE OWNER is performed before CREATE TABLE: %s", desired.statement)
 	}
-
-	// Examine policies in desiredTable to delete obsoleted policies later
-	if containsString(convertViewNames(g.desiredViews), desiredView.name) {
-		return nil, fmt.Errorf("view '%s' is doubly created: '%s'", desiredView.name, desiredView.statement)
+	ddl := fmt.Sprintf("ALTER TABLE %s OWNER TO %s", g.escapeTableName(desired.tableName), g.escapeSQLName(desired.owner))
+	if !g.isManagedRole(desired.owner) {
+		return unmanagedChanges(append(ddls, newChange(ChangeAlterOwner, desired.tableName, desired.owner, ddl))), nil
+	}
+	if currentTable.owner == desired.owner {
+		return ddls, nil
 	}
-	g.desiredViews = append(g.desiredViews, desiredView)
 
+	ddls = append(ddls, newChange(ChangeAlterOwner, desired.tableName, desired.owner, ddl))
+	currentTable.owner = desired.owner
 	return ddls, nil
 }
 
-// Workaround for: jsonb_extract_path_text(payload, array['amount'])
-// generated by jsonb_extract_path_text(payload, 'amount')
-// and collate, etc.
-func (g *Generator) normalizeViewDefinition(definition string) string {
-	definition = strings.ToLower(definition)
-	if g.mode == GeneratorModePostgres {
-		definition = strings.ReplaceAll(definition, "array[", "")
-		definition = strings.ReplaceAll(definition, "]", "")
-		definition = strings.ReplaceAll(definition, "collate", "")
-		definition = strings.ReplaceAll(definition, "  ", "")
+// Mark changes for a role absent in managed_roles, which are not compared with the current schema since it's not dumped
+func unmanagedChanges(changes []Change) []Change {
+	for i := range changes {
+		changes[i].Unmanaged = true
 	}
-	return definition
+	return changes
 }
 
-func (g *Generator) generateDDLsForCreateTrigger(triggerName string, desiredTrigger *Trigger) ([]string, error) {
-	var ddls []string
-	currentTrigger := findTriggerByName(g.currentTriggers, triggerName)
-
-	var triggerDefinition string
-	switch g.mode {
-	case GeneratorModeMssql:
-		triggerDefinition += fmt.Sprintf("TRIGGER %s ON %s %s %s AS\n%s", g.escapeSQLName(desiredTrigger.name), g.escapeTableName(desiredTrigger.tableName), desiredTrigger.time, strings.Join(desiredTrigger.event, ", "), strings.Join(desiredTrigger.body, "\n"))
-	case GeneratorModeMysql:
-		triggerDefinition += fmt.Sprintf("TRIGGER %s %s %s ON %s FOR EACH ROW %s", g.escapeSQLName(desiredTrigger.name), desiredTrigger.time, strings.Join(desiredTrigger.event, ", "), g.escapeTableName(desiredTrigger.tableName), strings.Join(desiredTrigger.body, "\n"))
-	case GeneratorModeSQLite3:
-		triggerDefinition = desiredTrigger.statement
-	default:
-		return ddls, nil
+// Table or view of a privilege, which is set to Change.Table
+func privilegeTable(privilege *Privilege) string {
+	if privilege.objectType == "TABLE" {
+		return privilege.name
 	}
+	return ""
+}
 
-	if currentTrigger == nil {
-		// Trigger not found, add trigger.
-		var createPrefix string
-		if g.mode != GeneratorModeSQLite3 {
-			createPrefix = "CREATE "
+// Enable or disable row level security of tables. This is done after policies are created not to reject queries meanwhile.
+func (g *Generator) generateDDLsForRowSecurity() []Change {
+	ddls := []Change{}
+	for _, desired := range g.desiredTables {
+		current := findTableByName(g.currentTables, desired.name)
+		if current == nil {
+			continue
 		}
-		ddls = append(ddls, createPrefix+triggerDefinition)
-	} else {
-		// Trigger found. If it's different, create or replace trigger.
-		if !areSameTriggerDefinition(currentTrigger, desiredTrigger) {
-			if g.mode != GeneratorModeMssql {
-				ddls = append(ddls, fmt.Sprintf("DROP TRIGGER %s", g.escapeSQLName(triggerName)))
+		if current.rowSecurity.enabled != desired.rowSecurity.enabled {
+			option := "DISABLE"
+			if desired.rowSecurity.enabled {
+				option = "ENABLE"
 			}
-			var createPrefix string
-			if g.mode == GeneratorModeMssql {
-				createPrefix = "CREATE OR ALTER "
-			} else if g.mode != GeneratorModeSQLite3 {
-				createPrefix = "CREATE "
+			ddl := fmt.Sprintf("ALTER TABLE %s %s ROW LEVEL SECURITY", g.escapeTableName(desired.name), option)
+			ddls = append(ddls, newChange(ChangeAlterRowSecurity, desired.name, desired.name, ddl))
+		}
+		if current.rowSecurity.forced != desired.rowSecurity.forced {
+			option := "NO FORCE"
+			if desired.rowSecurity.forced {
+				option = "FORCE"
 			}
-			ddls = append(ddls, createPrefix+triggerDefinition)
+			ddl := fmt.Sprintf("ALTER TABLE %s %s ROW LEVEL SECURITY", g.escapeTableName(desired.name), option)
+			ddls = append(ddls, newChange(ChangeAlterRowSecurity, desired.name, desired.name, ddl))
 		}
+		current.rowSecurity = desired.rowSecurity
 	}
-
-	g.desiredTriggers = append(g.desiredTriggers, desiredTrigger)
-
-	return ddls, nil
+	return ddls
 }
 
-func (g *Generator) generateDDLsForCreateType(desired *Type) ([]string, error) {
-	ddls := []string{}
+// Return the clause of ALTER SEQUENCE to change the options of current to desired, comparing unspecified options as defaults.
+func generateAlterSequenceClause(current *Sequence, desired *Sequence) string {
+	currentOptions := sequenceWithDefaults(current)
+	desiredOptions := sequenceWithDefaults(desired)
 
-	if currentType := findTypeByName(g.currentTypes, desired.name); currentType != nil {
-		// Type found. Add values if not present.
-		if currentType.enumValues != nil && len(currentType.enumValues) < len(desired.enumValues) {
-			for _, enumValue := range desired.enumValues {
-				if !containsString(currentType.enumValues, enumValue) {
-					ddl := fmt.Sprintf("ALTER TYPE %s ADD VALUE %s", currentType.name, enumValue)
-					ddls = append(ddls, ddl)
-				}
-			}
-		}
-	} else {
-		// Type not found, add type.
-		ddls = append(ddls, desired.statement)
+	var changed Sequence
+	if *currentOptions.IncrementBy != *desiredOptions.IncrementBy {
+		changed.IncrementBy = desiredOptions.IncrementBy
+	}
+	if *currentOptions.MinValue != *desiredOptions.MinValue {
+		changed.MinValue = desiredOptions.MinValue
+	}
+	if *currentOptions.MaxValue != *desiredOptions.MaxValue {
+		changed.MaxValue = desiredOptions.MaxValue
+	}
+	if *currentOptions.StartWith != *desiredOptions.StartWith {
+		changed.StartWith = desiredOptions.StartWith
+	}
+	if *currentOptions.Cache != *desiredOptions.Cache {
+		changed.Cache = desiredOptions.Cache
+	}
+	if currentOptions.Cycle != desiredOptions.Cycle {
+		changed.Cycle = desiredOptions.Cycle
+		changed.NoCycle = !desiredOptions.Cycle
 	}
-	g.desiredTypes = append(g.desiredTypes, desired)
 
-	return ddls, nil
+	var clauses []string
+	if currentOptions.Type != desiredOptions.Type {
+		clauses = append(clauses, "AS "+desiredOptions.Type)
+	}
+	if clause := generateSequenceClause(&changed); clause != "" {
+		clauses = append(clauses, clause)
+	}
+	return strings.Join(clauses, " ")
+}
+
+// Ranges of the data types of sequences
+var sequenceTypeRanges = map[string][2]int{
+	"smallint": {math.MinInt16, math.MaxInt16},
+	"integer":  {math.MinInt32, math.MaxInt32},
+	"bigint":   {math.MinInt64, math.MaxInt64},
 }
 
-func (g *Generator) generateDDLsForComment(desired *Comment) ([]string, error) {
-	ddls := []string{}
+// Fill unspecified options of a sequence with the defaults of PostgreSQL
+func sequenceWithDefaults(sequence *Sequence) Sequence {
+	result := Sequence{Type: sequence.Type, Cycle: sequence.Cycle}
+	if result.Type == "" {
+		result.Type = "bigint"
+	}
+	typeRange, ok := sequenceTypeRanges[result.Type]
+	if !ok {
+		typeRange = sequenceTypeRanges["bigint"]
+	}
 
-	currentComment := findCommentByObject(g.currentComments, desired.comment.Object)
-	if currentComment == nil || currentComment.comment.Comment != desired.comment.Comment {
-		// Comment not found, add comment.
-		ddls = append(ddls, desired.statement)
+	increment := 1
+	if sequence.IncrementBy != nil {
+		increment = *sequence.IncrementBy
+	}
+	minValue, maxValue := 1, typeRange[1]
+	if increment < 0 {
+		minValue, maxValue = typeRange[0], -1
+	}
+	if sequence.MinValue != nil {
+		minValue = *sequence.MinValue
+	}
+	if sequence.MaxValue != nil {
+		maxValue = *sequence.MaxValue
+	}
+	start := minValue
+	if increment < 0 {
+		start = maxValue
+	}
+	if sequence.StartWith != nil {
+		start = *sequence.StartWith
+	}
+	cache := 1
+	if sequence.Cache != nil {
+		cache = *sequence.Cache
 	}
 
-	return ddls, nil
+	result.IncrementBy = &increment
+	result.MinValue = &minValue
+	result.MaxValue = &maxValue
+	result.StartWith = &start
+	result.Cache = &cache
+	return result
 }
 
-func (g *Generator) generateDDLsForExtension(desired *Extension) ([]string, error) {
-	ddls := []string{}
+// Split `schema.table.column` of OWNED BY into the table and the column
+func splitSequenceOwner(ownedBy string) (string, string) {
+	i := strings.LastIndex(ownedBy, ".")
+	return ownedBy[:i], ownedBy[i+1:]
+}
 
-	if currentExtension := findExtensionByName(g.currentExtensions, desired.extension.Name); currentExtension == nil {
-		// Extension not found, add extension.
-		ddls = append(ddls, desired.statement)
-		extension := *desired // copy extension
-		g.currentExtensions = append(g.currentExtensions, &extension)
+func (g *Generator) generateDropFunction(function *Function) string {
+	kind := "FUNCTION"
+	if function.procedure {
+		kind = "PROCEDURE"
 	}
-
-	g.desiredExtensions = append(g.desiredExtensions, desired)
-
-	return ddls, nil
+	if g.mode == GeneratorModeMysql {
+		return fmt.Sprintf("DROP %s %s", kind, g.escapeTableName(function.name)) // MySQL doesn't overload functions
+	}
+	return fmt.Sprintf("DROP %s %s(%s)", kind, g.escapeTableName(function.name), strings.Join(function.arguments, ", "))
 }
 
-func (g *Generator) generateDDLsForSchema(desired *Schema) ([]string, error) {
-	ddls := []string{}
+func (g *Generator) generateDDLsForSchema(desired *Schema) ([]Change, error) {
+	ddls := []Change{}
 
 	if currentSchema := findSchemaByName(g.currentSchemas, desired.schema.Name); currentSchema == nil {
 		// Schema not found, add schema.
-		ddls = append(ddls, desired.statement)
+		ddls = append(ddls, newChange(ChangeCreateSchema, "", desired.schema.Name, desired.statement))
 		schema := *desired // copy schema
 		g.currentSchemas = append(g.currentSchemas, &schema)
 	}
@@ -1091,12 +2363,12 @@ func (g *Generator) generateDDLsForSchema(desired *Schema) ([]string, error) {
 
 // Even though simulated table doesn't have a foreign key, references could exist in column definitions.
 // This carefully generates DROP CONSTRAINT for such situations.
-func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey, currentTable Table, desiredTable Table) []string {
-	ddls := []string{}
+func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey, currentTable Table, desiredTable Table) []Change {
+	ddls := []Change{}
 
 	switch g.mode {
 	case GeneratorModeMysql:
-		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName)))
+		ddls = append(ddls, newAlterTableChange(ChangeDropForeignKey, currentTable.name, currentForeignKey.constraintName, fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName))))
 	case GeneratorModePostgres, GeneratorModeMssql:
 		var referencesColumn *Column
 		for _, column := range desiredTable.columns {
@@ -1107,7 +2379,7 @@ func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey
 		}
 
 		if referencesColumn == nil {
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName)))
+			ddls = append(ddls, newAlterTableChange(ChangeDropForeignKey, currentTable.name, currentForeignKey.constraintName, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName))))
 		}
 	default:
 	}
@@ -1117,8 +2389,8 @@ func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey
 
 // Even though simulated table doesn't have an index, primary or unique could exist in column definitions.
 // This carefully generates DROP INDEX for such situations.
-func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable Table, desiredTable Table) ([]string, error) {
-	ddls := []string{}
+func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable Table, desiredTable Table) ([]Change, error) {
+	ddls := []Change{}
 
 	if currentIndex.primary {
 		var primaryKeyColumn *Column
@@ -1133,7 +2405,7 @@ func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable
 			// If nil, it will be `DROP COLUMN`-ed and we can usually ignore it.
 			// However, it seems like you need to explicitly drop it first for MSSQL.
 			if g.mode == GeneratorModeMssql && (primaryKeyColumn == nil || primaryKeyColumn.name != currentIndex.columns[0].column) {
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentIndex.name)))
+				ddls = append(ddls, newAlterTableChange(ChangeDropPrimaryKey, currentTable.name, currentIndex.name, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentIndex.name))))
 			}
 		} else if primaryKeyColumn.name != currentIndex.columns[0].column { // TODO: check length of currentIndex.columns
 			// TODO: handle this. Rename primary key column...?
@@ -1156,10 +2428,10 @@ func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable
 
 		if uniqueKeyColumn == nil {
 			// No unique column. Drop unique key index.
-			ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name, currentIndex.constraint))
+			ddls = append(ddls, g.generateDropIndexChange(currentTable.name, currentIndex.name, currentIndex.constraint))
 		}
 	} else {
-		ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name, currentIndex.constraint))
+		ddls = append(ddls, g.generateDropIndexChange(currentTable.name, currentIndex.name, currentIndex.constraint))
 	}
 
 	return ddls, nil
@@ -1552,6 +2824,130 @@ func (g *Generator) generateDropIndex(tableName string, indexName string, constr
 	}
 }
 
+func (g *Generator) generateAddIndexChange(table string, index Index) Change {
+	change := newChange(indexChangeKind(index), table, index.name, g.generateAddIndex(table, index))
+	change.alterTable = g.mode != GeneratorModeMssql || index.primary // MSSQL uses `CREATE INDEX` for non-primary indexes
+	return change
+}
+
+func (g *Generator) generateDropIndexChange(tableName string, indexName string, constraint bool) Change {
+	kind := ChangeDropIndex
+	if constraint {
+		kind = ChangeDropConstraint
+	}
+	change := newChange(kind, tableName, indexName, g.generateDropIndex(tableName, indexName, constraint))
+	change.alterTable = g.mode == GeneratorModeMysql || (constraint && (g.mode == GeneratorModePostgres || g.mode == GeneratorModeMssql))
+	return change
+}
+
+// Return desired column names mapped to current column names that should be renamed to them.
+// They are given by `@renamed from` annotations.
+func (g *Generator) findRenamedColumns(currentTable Table, desiredTable Table) map[string]string {
+	renamedColumns := map[string]string{}
+	for _, desiredColumn := range desiredTable.columns {
+		if desiredColumn.renamedFrom == "" || findColumnByName(currentTable.columns, desiredColumn.name) != nil {
+			continue
+		}
+		if _, ok := desiredTable.columns[desiredColumn.renamedFrom]; ok {
+			continue // The old name is still desired
+		}
+		if findColumnByName(currentTable.columns, desiredColumn.renamedFrom) != nil {
+			renamedColumns[desiredColumn.name] = desiredColumn.renamedFrom
+		}
+	}
+	// Revert renames annotated in the current schema, e.g. when generating a rollback
+	for _, currentColumn := range currentTable.columns {
+		if currentColumn.renamedFrom == "" || findColumnByName(desiredTable.columns, currentColumn.name) != nil {
+			continue
+		}
+		if _, ok := renamedColumns[currentColumn.renamedFrom]; ok {
+			continue
+		}
+		if _, ok := currentTable.columns[currentColumn.renamedFrom]; ok {
+			continue // The old name is still used
+		}
+		if findColumnByName(desiredTable.columns, currentColumn.renamedFrom) != nil {
+			renamedColumns[currentColumn.renamedFrom] = currentColumn.name
+		}
+	}
+	return renamedColumns
+}
+
+// Guess a rename from a pair of an obsoleted column and a new column of the same type, which are the only ones
+// replaced in the table. The guess is only suggested to be confirmed by a `@renamed from` annotation.
+func (g *Generator) guessRenamedColumn(currentTable Table, desiredTable Table) (string, string, bool) {
+	var absentColumns, addedColumns []*Column
+	for _, column := range currentTable.columns {
+		if _, ok := desiredTable.columns[column.name]; !ok {
+			absentColumns = append(absentColumns, column)
+		}
+	}
+	for _, column := range desiredTable.columns {
+		if _, ok := currentTable.columns[column.name]; !ok {
+			addedColumns = append(addedColumns, column)
+		}
+	}
+	if len(absentColumns) == 1 && len(addedColumns) == 1 && g.haveSameDataType(*absentColumns[0], *addedColumns[0]) {
+		return absentColumns[0].name, addedColumns[0].name, true
+	}
+	return "", "", false
+}
+
+// Simulate renaming a column of table and return the renamed column
+func renameColumn(table *Table, currentName string, desiredName string) *Column {
+	column := *table.columns[currentName] // copy column
+	column.name = desiredName
+	delete(table.columns, currentName)
+	table.columns[desiredName] = &column
+
+	for i, index := range table.indexes {
+		for j, indexColumn := range index.columns {
+			if indexColumn.column == currentName {
+				table.indexes[i].columns[j].column = desiredName
+			}
+		}
+	}
+	return &column
+}
+
+// Renaming a table to another schema is not supported
+func (g *Generator) canRenameTable(currentName string, desiredName string) bool {
+	currentSchema, _ := splitTableName(currentName, g.defaultSchema)
+	desiredSchema, _ := splitTableName(desiredName, g.defaultSchema)
+	return currentSchema == desiredSchema
+}
+
+func (g *Generator) generateRenameTable(currentName string, desiredName string) Change {
+	_, desiredTable := splitTableName(desiredName, g.defaultSchema)
+	switch g.mode {
+	case GeneratorModeMysql:
+		return newChange(ChangeRenameTable, desiredName, desiredName, fmt.Sprintf("RENAME TABLE %s TO %s", g.escapeTableName(currentName), g.escapeTableName(desiredName)))
+	case GeneratorModeMssql:
+		return newChange(ChangeRenameTable, desiredName, desiredName, fmt.Sprintf("EXEC sp_rename %s, %s", StringConstant(currentName), StringConstant(desiredTable)))
+	default:
+		return newAlterTableChange(ChangeRenameTable, desiredName, desiredName, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", g.escapeTableName(currentName), g.escapeSQLName(desiredTable)))
+	}
+}
+
+// Rename a column. MySQL changes the column with its current definition since RENAME COLUMN requires MySQL 8.0.
+func (g *Generator) generateRenameColumn(tableName string, currentColumn Column, desiredName string) (Change, error) {
+	switch g.mode {
+	case GeneratorModeMysql:
+		renamedColumn := currentColumn
+		renamedColumn.name = desiredName
+		definition, err := g.generateColumnDefinition(renamedColumn, false)
+		if err != nil {
+			return Change{}, err
+		}
+		ddl := fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(tableName), g.escapeSQLName(currentColumn.name), definition)
+		return newAlterTableChange(ChangeRenameColumn, tableName, desiredName, ddl), nil
+	case GeneratorModeMssql:
+		return newChange(ChangeRenameColumn, tableName, desiredName, fmt.Sprintf("EXEC sp_rename %s, %s, 'COLUMN'", StringConstant(tableName+"."+currentColumn.name), StringConstant(desiredName))), nil
+	default:
+		return newAlterTableChange(ChangeRenameColumn, tableName, desiredName, fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", g.escapeTableName(tableName), g.escapeSQLName(currentColumn.name), g.escapeSQLName(desiredName))), nil
+	}
+}
+
 func (g *Generator) escapeTableName(name string) string {
 	switch g.mode {
 	case GeneratorModePostgres, GeneratorModeMssql:
@@ -1593,13 +2989,6 @@ func (g *Generator) notNull(column Column) bool {
 	}
 }
 
-func isAddConstraintForeignKey(ddl string) bool {
-	if strings.HasPrefix(ddl, "ALTER TABLE") && strings.Contains(ddl, "ADD CONSTRAINT") && strings.Contains(ddl, "FOREIGN KEY") {
-		return true
-	}
-	return false
-}
-
 func isPrimaryKey(column Column, table Table) bool {
 	if column.keyOption == ColumnKeyPrimary {
 		return true
@@ -1632,14 +3021,21 @@ func mergeTable(table1 *Table, table2 Table) {
 	}
 }
 
-func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type, []*Comment, []*Extension, []*Schema, error) {
+func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type, []*Domain, []*Comment, []*Extension, []*Schema, []*Function, []*Event, []*CreateSequence, []*Privilege, []*Role, []Membership, error) {
 	var tables []*Table
 	var views []*View
 	var triggers []*Trigger
 	var types []*Type
+	var domains []*Domain
 	var comments []*Comment
 	var extensions []*Extension
 	var schemas []*Schema
+	var functions []*Function
+	var events []*Event
+	var sequences []*CreateSequence
+	var privileges []*Privilege
+	var roles []*Role
+	var memberships []Membership
 	for _, ddl := range ddls {
 		switch stmt := ddl.(type) {
 		case *CreateTable:
@@ -1650,7 +3046,7 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 			if table == nil {
 				view := findViewByName(views, stmt.tableName)
 				if view == nil {
-					return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("CREATE INDEX is performed before CREATE TABLE: %s", ddl.Statement())
+					return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("CREATE INDEX is performed before CREATE TABLE: %s", ddl.Statement())
 				}
 				// TODO: check duplicated creation
 				view.indexes = append(view.indexes, stmt.index)
@@ -1661,14 +3057,14 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 		case *AddIndex:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD INDEX is performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD INDEX is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 			// TODO: check duplicated creation
 			table.indexes = append(table.indexes, stmt.index)
 		case *AddPrimaryKey:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 
 			newColumns := map[string]*Column{}
@@ -1682,21 +3078,21 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 		case *AddForeignKey:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD FOREIGN KEY is performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD FOREIGN KEY is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 
 			table.foreignKeys = append(table.foreignKeys, stmt.foreignKey)
 		case *AddExclusion:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD EXCLUDE is performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD EXCLUDE is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 
 			table.exclusions = append(table.exclusions, stmt.exclusion)
 		case *AddPolicy:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD POLICY performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD POLICY performed before CREATE TABLE: %s", ddl.Statement())
 			}
 
 			table.policies = append(table.policies, stmt.policy)
@@ -1706,17 +3102,53 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 			triggers = append(triggers, stmt)
 		case *Type:
 			types = append(types, stmt)
+		case *Domain:
+			domains = append(domains, stmt)
 		case *Comment:
 			comments = append(comments, stmt)
 		case *Extension:
 			extensions = append(extensions, stmt)
 		case *Schema:
 			schemas = append(schemas, stmt)
+		case *Function:
+			functions = append(functions, stmt)
+		case *Event:
+			events = append(events, stmt)
+		case *CreateSequence:
+			sequences = append(sequences, stmt)
+		case *AlterSequence:
+			sequence := findSequenceByName(sequences, stmt.name)
+			if sequence == nil {
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ALTER SEQUENCE is performed before CREATE SEQUENCE: %s", stmt.Statement())
+			}
+			sequence.sequence.OwnedBy = stmt.ownedBy
+		case *Grant:
+			privileges = mergePrivileges(privileges, stmt.privileges)
+		case *Role:
+			roles = append(roles, stmt)
+		case *GrantRole:
+			for _, membership := range stmt.memberships {
+				if !slices.Contains(memberships, membership) {
+					memberships = append(memberships, membership)
+				}
+			}
+		case *AlterRowSecurity:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ALTER TABLE ROW LEVEL SECURITY is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			stmt.apply(table)
+		case *AlterOwner:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ALTER TABLE OWNER is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			table.owner = stmt.owner
 		default:
-			return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("unexpected ddl type in convertDDLsToTablesAndViews: %#v", stmt)
+			return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("unexpected ddl type in convertDDLsToTablesAndViews: %#v", stmt)
 		}
 	}
-	return tables, views, triggers, types, comments, extensions, schemas, nil
+	return tables, views, triggers, types, domains, comments, extensions, schemas, functions, events, sequences, privileges, roles, memberships, nil
 }
 
 func findTableByName(tables []*Table, name string) *Table {
@@ -1728,6 +3160,33 @@ func findTableByName(tables []*Table, name string) *Table {
 	return nil
 }
 
+func findTableByRenamedFrom(tables []*Table, renamedFrom string) *Table {
+	for _, table := range tables {
+		if table.renamedFrom == renamedFrom {
+			return table
+		}
+	}
+	return nil
+}
+
+func isTableDesired(desiredDDLs []DDL, name string) bool {
+	for _, ddl := range desiredDDLs {
+		if createTable, ok := ddl.(*CreateTable); ok && createTable.table.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func findDesiredColumn(desiredDDLs []DDL, tableName string, columnName string) *Column {
+	for _, ddl := range desiredDDLs {
+		if createTable, ok := ddl.(*CreateTable); ok && createTable.table.name == tableName {
+			return findColumnByName(createTable.table.columns, columnName)
+		}
+	}
+	return nil
+}
+
 func findColumnByName(columns map[string]*Column, name string) *Column {
 	if column, ok := columns[name]; ok {
 		return column
@@ -1799,10 +3258,19 @@ func findViewByName(views []*View, name string) *View {
 	return nil
 }
 
-func findTriggerByName(triggers []*Trigger, name string) *Trigger {
-	for _, trigger := range triggers {
-		if trigger.name == name {
-			return trigger
+func findAttributeByName(attributes []Column, name string) *Column {
+	for i := range attributes {
+		if attributes[i].name == name {
+			return &attributes[i]
+		}
+	}
+	return nil
+}
+
+func findDomainByName(domains []*Domain, name string) *Domain {
+	for _, domain := range domains {
+		if domain.name == name {
+			return domain
 		}
 	}
 	return nil
@@ -1826,6 +3294,104 @@ func findCommentByObject(comments []*Comment, object string) *Comment {
 	return nil
 }
 
+func findSequenceByName(sequences []*CreateSequence, name string) *CreateSequence {
+	for _, sequence := range sequences {
+		if sequence.name == name {
+			return sequence
+		}
+	}
+	return nil
+}
+
+func findRoleByName(roles []*Role, name string) *Role {
+	for _, role := range roles {
+		if role.role.Name == name {
+			return role
+		}
+	}
+	return nil
+}
+
+// Options of CREATE ROLE or ALTER ROLE to change current to desired, e.g. LOGIN or NOINHERIT
+func roleOptions(current parser.Role, desired parser.Role) []string {
+	var options []string
+	for _, option := range []struct {
+		name    string
+		current bool
+		desired bool
+	}{
+		{"SUPERUSER", current.Superuser, desired.Superuser},
+		{"CREATEDB", current.CreateDB, desired.CreateDB},
+		{"CREATEROLE", current.CreateRole, desired.CreateRole},
+		{"INHERIT", current.Inherit, desired.Inherit},
+		{"LOGIN", current.Login, desired.Login},
+		{"REPLICATION", current.Replication, desired.Replication},
+		{"BYPASSRLS", current.BypassRLS, desired.BypassRLS},
+	} {
+		if option.current != option.desired {
+			if option.desired {
+				options = append(options, option.name)
+			} else {
+				options = append(options, "NO"+option.name)
+			}
+		}
+	}
+	if current.ConnectionLimit != desired.ConnectionLimit {
+		options = append(options, fmt.Sprintf("CONNECTION LIMIT %d", desired.ConnectionLimit))
+	}
+	return options
+}
+
+// A role created by `CREATE ROLE name` without options
+func defaultRole(name string) parser.Role {
+	return parser.Role{Name: name, Inherit: true, ConnectionLimit: -1}
+}
+
+func findPrivilege(privileges []*Privilege, target *Privilege) *Privilege {
+	for _, privilege := range privileges {
+		if privilege.isSameTarget(target) {
+			return privilege
+		}
+	}
+	return nil
+}
+
+// Merge privileges on the same object to the same grantee, which may be granted by multiple GRANTs
+func mergePrivileges(privileges []*Privilege, added []*Privilege) []*Privilege {
+	for _, privilege := range added {
+		if merged := findPrivilege(privileges, privilege); merged != nil {
+			merged.add(privilege.privileges, false)
+			merged.add(privilege.grantable, true)
+		} else {
+			privilege := *privilege // copy privilege
+			privilege.privileges = slices.Clone(privilege.privileges)
+			privilege.grantable = slices.Clone(privilege.grantable)
+			privileges = append(privileges, &privilege)
+		}
+	}
+	return privileges
+}
+
+// Find a function with the signature. MySQL procedures and functions are in separate namespaces, and they have
+// no arguments in their signatures.
+func (g *Generator) findFunction(functions []*Function, signature string, procedure bool) *Function {
+	for _, function := range functions {
+		if function.signature() == signature && (g.mode != GeneratorModeMysql || function.procedure == procedure) {
+			return function
+		}
+	}
+	return nil
+}
+
+func findEventByName(events []*Event, name string) *Event {
+	for _, event := range events {
+		if event.name == name {
+			return event
+		}
+	}
+	return nil
+}
+
 func findExtensionByName(extensions []*Extension, name string) *Extension {
 	for _, extension := range extensions {
 		if extension.extension.Name == name {
@@ -1897,6 +3463,56 @@ func (g *Generator) haveSameDataType(current Column, desired Column) bool {
 	return true
 }
 
+func areSamePartitionOf(partitionA *PartitionOf, partitionB *PartitionOf) bool {
+	if partitionA == nil || partitionB == nil {
+		return partitionA == nil && partitionB == nil
+	}
+	return partitionA.parent == partitionB.parent &&
+		normalizePartitionBoundForComparison(partitionA.bound) == normalizePartitionBoundForComparison(partitionB.bound)
+}
+
+// Return the index of the partition whose name and values are the same as partition, or -1
+func findPartitionIndex(partitions []*parser.PartitionDefinition, partition *parser.PartitionDefinition) int {
+	for i, p := range partitions {
+		if p.Name.String() == partition.Name.String() && p.Values == partition.Values {
+			return i
+		}
+	}
+	return -1
+}
+
+func findPartitionByName(partitions []*parser.PartitionDefinition, name string) *parser.PartitionDefinition {
+	for _, partition := range partitions {
+		if partition.Name.String() == name {
+			return partition
+		}
+	}
+	return nil
+}
+
+func partitionDefinitions(partitions []*parser.PartitionDefinition) string {
+	var definitions []string
+	for _, partition := range partitions {
+		definitions = append(definitions, partition.Definition)
+	}
+	return strings.Join(definitions, ", ")
+}
+
+func partitionNames(partitions []*parser.PartitionDefinition) string {
+	var names []string
+	for _, partition := range partitions {
+		names = append(names, partition.Name.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+// PostgreSQL shows bounds of timestamp columns with their time and time zone, e.g. '2024-01-01 00:00:00+00'
+func normalizePartitionBoundForComparison(bound string) string {
+	return partitionBoundMidnightPattern.ReplaceAllString(bound, "'$1'")
+}
+
+var partitionBoundMidnightPattern = regexp.MustCompile(`'(\d{4}-\d{2}-\d{2}) 00:00:00(?:[+-]\d{2}(?::\d{2})?)?'`)
+
 func areSameCheckDefinition(checkA *CheckDefinition, checkB *CheckDefinition) bool {
 	if checkA == nil && checkB == nil {
 		return true
@@ -1926,6 +3542,12 @@ func normalizeCheckDefinitionForComparison(def string) string {
 	return result
 }
 
+// PostgreSQL also casts columns in WHEN conditions of triggers, e.g. (old.name)::text for a varchar column
+func normalizeTriggerDefinitionForComparison(def string) string {
+	result := normalizeCheckDefinitionForComparison(def)
+	return regexp.MustCompile(`::(text|character varying)\b`).ReplaceAllString(result, "")
+}
+
 func areSameIdentityDefinition(identityA *Identity, identityB *Identity) bool {
 	if identityA == nil && identityB == nil {
 		return true
@@ -1952,14 +3574,27 @@ func (g *Generator) areSameDefaultValue(currentDefault *DefaultDefinition, desir
 	var currentExprSchema, currentExpr string
 	var desiredExprSchema, desiredExpr string
 	if currentDefault != nil {
-		currentExprSchema, currentExpr = splitTableName(currentDefault.expression, g.defaultSchema)
+		currentExprSchema, currentExpr = splitTableName(g.normalizeNextval(currentDefault.expression), g.defaultSchema)
 	}
 	if desiredDefault != nil {
-		desiredExprSchema, desiredExpr = splitTableName(desiredDefault.expression, g.defaultSchema)
+		desiredExprSchema, desiredExpr = splitTableName(g.normalizeNextval(desiredDefault.expression), g.defaultSchema)
 	}
 	return strings.ToLower(currentExprSchema) == strings.ToLower(desiredExprSchema) && strings.ToLower(currentExpr) == strings.ToLower(desiredExpr)
 }
 
+var nextvalPattern = regexp.MustCompile(`(?i)^nextval\('([^']*)'(::regclass)?\)$`)
+
+// Normalize nextval('public.seq'::regclass), which PostgreSQL shows for nextval('seq'), to nextval('seq')
+func (g *Generator) normalizeNextval(expr string) string {
+	if g.mode != GeneratorModePostgres {
+		return expr
+	}
+	if match := nextvalPattern.FindStringSubmatch(expr); match != nil {
+		return fmt.Sprintf("nextval('%s')", strings.TrimPrefix(match[1], g.defaultSchema+"."))
+	}
+	return expr
+}
+
 func (g *Generator) areSameValue(current, desired *Value) bool {
 	if current == nil && desired == nil {
 		return true
@@ -2006,6 +3641,9 @@ func areSameTriggerDefinition(triggerA, triggerB *Trigger) bool {
 	if triggerA.tableName != triggerB.tableName {
 		return false
 	}
+	if normalizeTriggerDefinitionForComparison(triggerA.definition) != normalizeTriggerDefinitionForComparison(triggerB.definition) {
+		return false
+	}
 	if len(triggerA.body) != len(triggerB.body) {
 		return false
 	}
@@ -2422,6 +4060,8 @@ func FilterTables(ddls []DDL, config database.GeneratorConfig) []DDL {
 			tables = append(tables, stmt.foreignKey.referenceName)
 		case *AddIndex:
 			tables = append(tables, stmt.tableName)
+		case *Trigger:
+			tables = append(tables, stmt.tableName)
 		}
 
 		if skipTables(tables, config) {
@@ -2501,6 +4141,13 @@ func splitTableName(table string, defaultSchema string) (string, string) {
 	}
 }
 
+// Whether the change is run by the online schema change command, which doesn't take `ALGORITHM=` and `LOCK=`
+func (g *Generator) isOnlineSchemaChange(change Change) bool {
+	config := database.GeneratorConfig{OnlineSchemaChange: g.onlineSchemaChange, OnlineSchemaChangeTables: g.onlineSchemaChangeTables}
+	_, ok := OnlineSchemaChangeClause(g.mode, change, config)
+	return ok
+}
+
 func isValidAlgorithm(algorithm string) bool {
 	switch strings.ToUpper(algorithm) {
 	case "INPLACE", "COPY", "INSTANT":
@@ -42,6 +42,7 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 			if err != nil {
 				return nil, err
 			}
+			parseRenameHints(mode, &table, ddl, defaultSchema)
 			return &CreateTable{
 				statement: ddl,
 				table:     table,
@@ -152,6 +153,8 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 				name:         normalizedTableName(mode, stmt.View.Name, defaultSchema),
 				definition:   parser.String(stmt.View.Definition),
 				columns:      columns,
+				withNoData:   stmt.View.WithNoData,
+				normalized:   stmt.View.Normalized,
 			}, nil
 		} else if stmt.Action == parser.CreateTrigger {
 			body := []string{}
@@ -159,19 +162,46 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 				body = append(body, parser.String(triggerStatement))
 			}
 
+			tableName := stmt.Trigger.TableName.Name.String()
+			if mode == GeneratorModePostgres { // PostgreSQL triggers are identified by their table as well
+				tableName = normalizedTableName(mode, stmt.Trigger.TableName, defaultSchema)
+			}
+
 			return &Trigger{
-				statement: ddl,
-				name:      stmt.Trigger.Name.String(),
-				tableName: stmt.Trigger.TableName.Name.String(),
-				time:      stmt.Trigger.Time,
-				event:     stmt.Trigger.Event,
-				body:      body,
+				statement:  ddl,
+				name:       stmt.Trigger.Name.String(),
+				tableName:  tableName,
+				time:       stmt.Trigger.Time,
+				event:      stmt.Trigger.Event,
+				body:       body,
+				constraint: stmt.Trigger.Constraint,
+				definition: stmt.Trigger.Definition,
 			}, nil
 		} else if stmt.Action == parser.CreateType {
+			var attributes []Column
+			for i, attribute := range stmt.Type.Attributes {
+				attributes = append(attributes, parseColumn(mode, attribute, i, parser.TableSpec{}, defaultSchema))
+			}
 			return &Type{
-				name:       normalizedTableName(mode, stmt.Type.Name, defaultSchema),
-				statement:  ddl,
-				enumValues: stmt.Type.Type.EnumValues,
+				name:          normalizedTableName(mode, stmt.Type.Name, defaultSchema),
+				statement:     ddl,
+				enumValues:    stmt.Type.Type.EnumValues,
+				attributes:    attributes,
+				renamedValues: parseEnumRenameHints(stmt.Type.Type.EnumValues, ddl),
+			}, nil
+		} else if stmt.Action == parser.CreateDomain {
+			var checks []CheckDefinition
+			for _, check := range stmt.Domain.Checks {
+				checks = append(checks, CheckDefinition{
+					definition:     parser.String(check.Where.Expr),
+					constraintName: parser.String(check.ConstraintName),
+				})
+			}
+			return &Domain{
+				statement: ddl,
+				name:      normalizedTableName(mode, stmt.Domain.Name, defaultSchema),
+				column:    parseColumn(mode, &parser.ColumnDefinition{Type: stmt.Domain.Type}, 0, parser.TableSpec{}, defaultSchema),
+				checks:    checks,
 			}, nil
 		} else if stmt.Action == parser.CommentOn {
 			return &Comment{
@@ -188,6 +218,73 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 				statement: ddl,
 				schema:    *stmt.Schema,
 			}, nil
+		} else if stmt.Action == parser.CreateSequence {
+			sequence := parseSequence(stmt.Sequence)
+			sequence.OwnedBy = normalizedSequenceOwner(sequence.OwnedBy, defaultSchema)
+			return &CreateSequence{
+				statement: ddl,
+				name:      normalizedTableName(mode, stmt.Table, defaultSchema),
+				sequence:  sequence,
+			}, nil
+		} else if stmt.Action == parser.AlterSequence {
+			return &AlterSequence{
+				statement: ddl,
+				name:      normalizedTableName(mode, stmt.Table, defaultSchema),
+				ownedBy:   normalizedSequenceOwner(stmt.Sequence.OwnedBy, defaultSchema),
+			}, nil
+		} else if stmt.Action == parser.CreateFunction {
+			return &Function{
+				statement:  ddl,
+				name:       normalizedTableName(mode, stmt.Function.Name, defaultSchema),
+				procedure:  stmt.Function.Procedure,
+				arguments:  stmt.Function.Arguments,
+				returns:    stmt.Function.Returns,
+				definition: stmt.Function.Definition,
+			}, nil
+		} else if stmt.Action == parser.CreateEvent {
+			return &Event{
+				statement:  ddl,
+				name:       normalizedTableName(mode, stmt.Event.Name, defaultSchema),
+				starts:     stmt.Event.Starts,
+				definition: stmt.Event.Definition,
+			}, nil
+		} else if stmt.Action == parser.CreateRole {
+			statement := fmt.Sprintf(`CREATE ROLE "%s"`, stmt.Role.Name)
+			if options := roleOptions(defaultRole(stmt.Role.Name), *stmt.Role); len(options) > 0 {
+				statement += " WITH " + strings.Join(options, " ")
+			}
+			return &Role{
+				statement: statement,
+				role:      *stmt.Role,
+			}, nil
+		} else if stmt.Action == parser.GrantMembership {
+			var memberships []Membership
+			for _, role := range stmt.GrantRole.Roles {
+				for _, member := range stmt.GrantRole.Members {
+					memberships = append(memberships, Membership{role: role, member: member})
+				}
+			}
+			return &GrantRole{
+				statement:   ddl,
+				memberships: memberships,
+			}, nil
+		} else if stmt.Action == parser.AlterOwner {
+			return &AlterOwner{
+				statement: ddl,
+				tableName: normalizedTableName(mode, stmt.Table, defaultSchema),
+				owner:     stmt.Owner,
+			}, nil
+		} else if stmt.Action == parser.AlterRowSecurity {
+			return &AlterRowSecurity{
+				statement: ddl,
+				tableName: normalizedTableName(mode, stmt.Table, defaultSchema),
+				option:    stmt.RowSecurity,
+			}, nil
+		} else if stmt.Action == parser.GrantPrivilege {
+			return &Grant{
+				statement:  ddl,
+				privileges: parsePrivileges(mode, stmt.Grant, defaultSchema),
+			}, nil
 		} else {
 			return nil, fmt.Errorf(
 				"unsupported type of DDL action '%d': %s",
@@ -199,6 +296,27 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 	}
 }
 
+func parsePrivileges(mode GeneratorMode, grant *parser.Grant, defaultSchema string) []*Privilege {
+	var privileges []*Privilege
+	for _, object := range grant.Objects {
+		name := object.Name.Name.String()
+		if grant.ObjectType != "SCHEMA" {
+			name = normalizedTableName(mode, object.Name, defaultSchema)
+		}
+		for _, grantee := range grant.Grantees {
+			privilege := &Privilege{
+				objectType: grant.ObjectType,
+				name:       name,
+				arguments:  object.Arguments,
+				grantee:    grantee,
+			}
+			privilege.add(grant.Privileges, grant.WithGrantOption)
+			privileges = append(privileges, privilege)
+		}
+	}
+	return privileges
+}
+
 func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Table, error) {
 	var columns = map[string]*Column{}
 	var indexes []Index
@@ -207,39 +325,7 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 	var exclusions []Exclusion
 
 	for i, parsedCol := range stmt.TableSpec.Columns {
-		column := Column{
-			name:          parsedCol.Name.String(),
-			position:      i,
-			typeName:      parsedCol.Type.Type,
-			unsigned:      castBool(parsedCol.Type.Unsigned),
-			notNull:       castBoolPtr(parsedCol.Type.NotNull),
-			autoIncrement: castBool(parsedCol.Type.Autoincrement),
-			array:         castBool(parsedCol.Type.Array),
-			defaultDef:    parseDefaultDefinition(parsedCol.Type.Default),
-			sridDef:       parseSridDefinition(parsedCol.Type.Srid),
-			length:        parseValue(parsedCol.Type.Length),
-			scale:         parseValue(parsedCol.Type.Scale),
-			displayWidth:  parseValue(parsedCol.Type.DisplayWidth),
-			charset:       parsedCol.Type.Charset,
-			collate:       normalizeCollate(parsedCol.Type.Collate, *stmt.TableSpec),
-			timezone:      castBool(parsedCol.Type.Timezone),
-			keyOption:     ColumnKeyOption(parsedCol.Type.KeyOpt), // FIXME: tight coupling in enum order
-			onUpdate:      parseValue(parsedCol.Type.OnUpdate),
-			comment:       parseValue(parsedCol.Type.Comment),
-			enumValues:    parsedCol.Type.EnumValues,
-			references:    normalizedTable(mode, parsedCol.Type.References, defaultSchema),
-			identity:      parseIdentity(parsedCol.Type.Identity),
-			sequence:      parseIdentitySequence(parsedCol.Type.Identity),
-			generated:     parseGenerated(parsedCol.Type.Generated),
-		}
-		if parsedCol.Type.Check != nil {
-			column.check = &CheckDefinition{
-				definition:        parser.String(parsedCol.Type.Check.Where.Expr),
-				constraintName:    parser.String(parsedCol.Type.Check.ConstraintName),
-				notForReplication: parsedCol.Type.Check.NotForReplication,
-				noInherit:         castBool(parsedCol.Type.Check.NoInherit),
-			}
-		}
+		column := parseColumn(mode, parsedCol, i, *stmt.TableSpec, defaultSchema)
 		columns[parsedCol.Name.String()] = &column
 	}
 
@@ -375,9 +461,22 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 		foreignKeys: foreignKeys,
 		exclusions:  exclusions,
 		options:     stmt.TableSpec.Options,
+		partitionBy: stmt.TableSpec.PartitionBy,
+		partitionOf: parsePartitionOf(mode, stmt.TableSpec.PartitionOf, defaultSchema),
+		partition:   stmt.TableSpec.Partition,
 	}, nil
 }
 
+func parsePartitionOf(mode GeneratorMode, partitionOf *parser.PartitionOf, defaultSchema string) *PartitionOf {
+	if partitionOf == nil {
+		return nil
+	}
+	return &PartitionOf{
+		parent: normalizedTableName(mode, partitionOf.Parent, defaultSchema),
+		bound:  partitionOf.Bound,
+	}
+}
+
 func parseIndex(stmt *parser.DDL) (Index, error) {
 	if stmt.IndexSpec == nil {
 		return Index{}, fmt.Errorf("stmt.IndexSpec was null on parseIndex: %#v", stmt)
@@ -456,6 +555,7 @@ func parseIndex(stmt *parser.DDL) (Index, error) {
 		constraint:        stmt.IndexSpec.Constraint,
 		constraintOptions: constraintOptions,
 		clustered:         stmt.IndexSpec.Clustered,
+		concurrently:      stmt.IndexSpec.Concurrently,
 		where:             where,
 		included:          includedColumns,
 		options:           indexOptions,
@@ -568,42 +668,54 @@ func parseIdentitySequence(opt *parser.IdentityOpt) *Sequence {
 	if opt == nil || opt.Sequence == nil {
 		return nil
 	}
+	return parseSequence(opt.Sequence)
+}
+
+func parseSequence(sequence *parser.Sequence) *Sequence {
 	seq := &Sequence{
-		Name:        opt.Sequence.Name,
-		IfNotExists: opt.Sequence.IfNotExists,
-		Type:        opt.Sequence.Type,
-		OwnedBy:     opt.Sequence.OwnedBy,
+		Name:        sequence.Name,
+		IfNotExists: sequence.IfNotExists,
+		Type:        sequence.Type,
+		OwnedBy:     sequence.OwnedBy,
 	}
-	if opt.Sequence.IncrementBy != nil {
-		seq.IncrementBy = &parseValue(opt.Sequence.IncrementBy).intVal
+	if sequence.IncrementBy != nil {
+		seq.IncrementBy = &parseValue(sequence.IncrementBy).intVal
 	}
-	if opt.Sequence.MinValue != nil {
-		seq.MinValue = &parseValue(opt.Sequence.MinValue).intVal
+	if sequence.MinValue != nil {
+		seq.MinValue = &parseValue(sequence.MinValue).intVal
 	}
-	if opt.Sequence.MaxValue != nil {
-		seq.MaxValue = &parseValue(opt.Sequence.MaxValue).intVal
+	if sequence.MaxValue != nil {
+		seq.MaxValue = &parseValue(sequence.MaxValue).intVal
 	}
-	if opt.Sequence.StartWith != nil {
-		seq.StartWith = &parseValue(opt.Sequence.StartWith).intVal
+	if sequence.StartWith != nil {
+		seq.StartWith = &parseValue(sequence.StartWith).intVal
 	}
-	if opt.Sequence.Cache != nil {
-		seq.Cache = &parseValue(opt.Sequence.Cache).intVal
+	if sequence.Cache != nil {
+		seq.Cache = &parseValue(sequence.Cache).intVal
 	}
-	if opt.Sequence.NoMinValue != nil {
+	if sequence.NoMinValue != nil {
 		seq.NoMinValue = true
 	}
-	if opt.Sequence.NoMaxValue != nil {
+	if sequence.NoMaxValue != nil {
 		seq.NoMaxValue = true
 	}
-	if opt.Sequence.Cycle != nil {
+	if sequence.Cycle != nil {
 		seq.Cycle = true
 	}
-	if opt.Sequence.NoCycle != nil {
+	if sequence.NoCycle != nil {
 		seq.NoCycle = true
 	}
 	return seq
 }
 
+// Qualify the table of `OWNED BY table.column` with the default schema
+func normalizedSequenceOwner(ownedBy string, defaultSchema string) string {
+	if strings.Count(ownedBy, ".") == 1 {
+		return defaultSchema + "." + ownedBy
+	}
+	return ownedBy
+}
+
 func parseGenerated(genc *parser.GeneratedColumn) *Generated {
 	if genc == nil {
 		return nil
@@ -664,7 +776,129 @@ func normalizedTable(mode GeneratorMode, tableName string, defaultSchema string)
 	}
 }
 
+var (
+	renameHintPattern    = regexp.MustCompile("(?:--|/\\*)\\s*@renamed\\s+from\\s+(\"[^\"]+\"|`[^`]+`|\\[[^\\]]+\\]|[^\\s,;*]+)")
+	createTablePattern   = regexp.MustCompile("(?i)^CREATE\\s.*\\bTABLE\\b")
+	leadingColumnPattern = regexp.MustCompile("^\\(?\\s*(\"[^\"]+\"|`[^`]+`|\\[[^\\]]+\\]|[^\\s(]+)")
+	enumValuePattern     = regexp.MustCompile("'(?:[^']|'')*'")
+)
+
+// Parse `-- @renamed from old_name` annotations in `CREATE TABLE`. An annotation is applied to the column or table
+// defined in the same line, or in the next line if the comment has its own line.
+func parseRenameHints(mode GeneratorMode, table *Table, ddl string, defaultSchema string) {
+	var pendingHint string
+	for _, line := range strings.Split(ddl, "\n") {
+		code := line
+		hint := pendingHint
+		if match := renameHintPattern.FindStringSubmatchIndex(line); match != nil {
+			code = line[:match[0]]
+			hint = normalizeRenameHint(mode, line[match[2]:match[3]])
+		}
+		code = strings.TrimSpace(code)
+		if code == "" {
+			pendingHint = hint
+			continue
+		}
+		pendingHint = ""
+		if hint == "" {
+			continue
+		}
+
+		if createTablePattern.MatchString(code) {
+			// An unqualified name is in the same schema as the table
+			schema, _ := splitTableName(table.name, defaultSchema)
+			table.renamedFrom = normalizedTable(mode, hint, schema)
+		} else if match := leadingColumnPattern.FindStringSubmatch(code); match != nil {
+			name := normalizeRenameHint(mode, match[1])
+			if column, ok := table.columns[name]; ok {
+				column.renamedFrom = hint
+			}
+		}
+	}
+}
+
+// Parse `-- @renamed from 'old_value'` annotations in `CREATE TYPE ... AS ENUM`. An annotation is applied to the last
+// value defined in the same line, or to the first value in the next line if the comment has its own line.
+// This returns desired values mapped to the current values renamed to them.
+func parseEnumRenameHints(enumValues []string, ddl string) map[string]string {
+	renamedValues := map[string]string{}
+	var pendingHint string
+	for _, line := range strings.Split(ddl, "\n") {
+		code := line
+		var hint string
+		if match := renameHintPattern.FindStringSubmatchIndex(line); match != nil {
+			code = line[:match[0]]
+			hint = line[match[2]:match[3]]
+			if !strings.HasPrefix(hint, "'") {
+				hint = "'" + hint + "'"
+			}
+		}
+		values := enumValuePattern.FindAllString(code, -1)
+		if len(values) == 0 {
+			if hint != "" {
+				pendingHint = hint
+			}
+			continue
+		}
+
+		if hint != "" && containsString(enumValues, values[len(values)-1]) {
+			renamedValues[values[len(values)-1]] = hint
+		}
+		if pendingHint != "" && containsString(enumValues, values[0]) {
+			renamedValues[values[0]] = pendingHint
+		}
+		pendingHint = ""
+	}
+	return renamedValues
+}
+
+func normalizeRenameHint(mode GeneratorMode, name string) string {
+	if strings.ContainsAny(name[:1], "\"`[") {
+		return name[1 : len(name)-1]
+	} else if mode == GeneratorModePostgres {
+		return strings.ToLower(name) // unquoted identifiers are folded to lower case
+	}
+	return name
+}
+
 // Replace pseudo collation "binary" with "{charset}_bin"
+func parseColumn(mode GeneratorMode, parsedCol *parser.ColumnDefinition, position int, tableSpec parser.TableSpec, defaultSchema string) Column {
+	column := Column{
+		name:          parsedCol.Name.String(),
+		position:      position,
+		typeName:      parsedCol.Type.Type,
+		unsigned:      castBool(parsedCol.Type.Unsigned),
+		notNull:       castBoolPtr(parsedCol.Type.NotNull),
+		autoIncrement: castBool(parsedCol.Type.Autoincrement),
+		array:         castBool(parsedCol.Type.Array),
+		defaultDef:    parseDefaultDefinition(parsedCol.Type.Default),
+		sridDef:       parseSridDefinition(parsedCol.Type.Srid),
+		length:        parseValue(parsedCol.Type.Length),
+		scale:         parseValue(parsedCol.Type.Scale),
+		displayWidth:  parseValue(parsedCol.Type.DisplayWidth),
+		charset:       parsedCol.Type.Charset,
+		collate:       normalizeCollate(parsedCol.Type.Collate, tableSpec),
+		timezone:      castBool(parsedCol.Type.Timezone),
+		keyOption:     ColumnKeyOption(parsedCol.Type.KeyOpt), // FIXME: tight coupling in enum order
+		onUpdate:      parseValue(parsedCol.Type.OnUpdate),
+		comment:       parseValue(parsedCol.Type.Comment),
+		enumValues:    parsedCol.Type.EnumValues,
+		references:    normalizedTable(mode, parsedCol.Type.References, defaultSchema),
+		identity:      parseIdentity(parsedCol.Type.Identity),
+		sequence:      parseIdentitySequence(parsedCol.Type.Identity),
+		generated:     parseGenerated(parsedCol.Type.Generated),
+	}
+	if parsedCol.Type.Check != nil {
+		column.check = &CheckDefinition{
+			definition:        parser.String(parsedCol.Type.Check.Where.Expr),
+			constraintName:    parser.String(parsedCol.Type.Check.ConstraintName),
+			notForReplication: parsedCol.Type.Check.NotForReplication,
+			noInherit:         castBool(parsedCol.Type.Check.NoInherit),
+		}
+	}
+	return column
+}
+
 func normalizeCollate(collate string, table parser.TableSpec) string {
 	if collate == "binary" {
 		return table.Options["default charset"] + "_bin"
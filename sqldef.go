@@ -1,11 +1,17 @@
 package sqldef
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"os/exec"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/sqldef/sqldef/v2/database"
 	"github.com/sqldef/sqldef/v2/schema"
@@ -15,20 +21,94 @@ type Options struct {
 	DesiredDDLs string
 	CurrentFile string
 	DryRun      bool
+	Check       bool
 	Export      bool
+	Output      string // "text" (default) or "json"
 	EnableDrop  bool
 	BeforeApply string
-	Config      database.GeneratorConfig
+	// File to write DDLs that revert the changes to. Rollback is not generated if this is empty.
+	RollbackOutput string
+	// Directory to write migration files of MigrationFormat to, instead of applying DDLs
+	WriteMigration  string
+	MigrationFormat string // MigrationFormatGolangMigrate (default), MigrationFormatFlyway, MigrationFormatGoose or MigrationFormatDbmate
+	// Database whose schema is used as the desired one instead of DesiredDDLs, e.g. to diff two live databases
+	DesiredDatabase database.Database
+	Config          database.GeneratorConfig
+	// Called by Apply just before each change is executed, and with skipped true in place of each Skipped change.
+	// BeforeApply is executed before the first call of this.
+	BeforeExecute func(change schema.Change, skipped bool)
+}
+
+const OutputJSON = "json"
+
+// Exit status of Run when options.Check is true and the current schema differs from the desired one
+const ExitCodeDrift = 2
+
+// Result of Plan or Apply
+type Result struct {
+	// Changes generated to migrate the current schema to the desired one, in execution order.
+	// This includes Skipped changes.
+	Changes []schema.Change
+	// Changes that are not executed because they are Destructive and enabled by neither EnableDrop nor
+	// Config.EnableDrop, only Suggested, or Unmanaged. This is an ordered subset of Changes.
+	Skipped []SkippedChange
+	// Changes to migrate the desired schema back to the current one, generated only when RollbackOutput or
+	// WriteMigration is set.
+	// Changes restoring objects whose drop is Skipped are excluded since they are not dropped.
+	Rollback []schema.Change
+
+	mergeAlterTable bool // Execute Changes and Rollback with schema.MergeAlterTables
+}
+
+// A change in Result.Skipped with the reason why it's not executed
+type SkippedChange struct {
+	schema.Change
+	Reason string `json:"reason"`
+}
+
+// Returned when the current schema cannot be dumped
+type DumpError struct {
+	Err error
+}
+
+func (e *DumpError) Error() string {
+	return fmt.Sprintf("Error on DumpDDLs: %s", e.Err)
+}
+
+func (e *DumpError) Unwrap() error {
+	return e.Err
+}
+
+// Returned when the desired or current schema cannot be parsed, or DDLs cannot be generated from them
+type GenerateError struct {
+	Err error
+}
+
+func (e *GenerateError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *GenerateError) Unwrap() error {
+	return e.Err
+}
+
+// Returned when a DDL fails on Apply. DDL is empty when the failure is not specific to a DDL, e.g. COMMIT.
+type ApplyError struct {
+	DDL string
+	Err error
+}
+
+func (e *ApplyError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ApplyError) Unwrap() error {
+	return e.Err
 }
 
 // Main function shared by all commands
 func Run(generatorMode schema.GeneratorMode, db database.Database, sqlParser database.Parser, options *Options) {
-	currentDDLs, err := db.DumpDDLs()
-	if err != nil {
-		log.Fatalf("Error on DumpDDLs: %s", err)
-	}
-
-	defaultSchema := db.GetDefaultSchema()
+	ctx := context.Background()
 
 	var ddlSuffix string
 	if generatorMode == schema.GeneratorModeMssql {
@@ -38,43 +118,545 @@ func Run(generatorMode schema.GeneratorMode, db database.Database, sqlParser dat
 	}
 
 	if options.Export {
-		if currentDDLs == "" {
+		ddls, err := Export(ctx, generatorMode, db, sqlParser, options)
+		if err != nil {
+			exitWithError(err)
+		}
+		if options.Output == OutputJSON {
+			showExportJSON(ddls)
+			return
+		}
+		if len(ddls) == 0 {
 			fmt.Printf("-- No table exists --\n")
-		} else {
-			ddls, err := schema.ParseDDLs(generatorMode, sqlParser, currentDDLs, defaultSchema)
-			if err != nil {
-				log.Fatal(err)
-			}
-			ddls = schema.FilterTables(ddls, options.Config)
-			ddls = schema.FilterViews(ddls, options.Config)
-			for i, ddl := range ddls {
-				if i > 0 {
-					fmt.Println()
-				}
-				fmt.Printf("%s;\n", ddl.Statement())
-				fmt.Print(ddlSuffix)
+		}
+		for i, ddl := range ddls {
+			if i > 0 {
+				fmt.Println()
 			}
+			fmt.Printf("%s;\n", ddl.Statement())
+			fmt.Print(ddlSuffix)
 		}
 		return
 	}
 
-	ddls, err := schema.GenerateIdempotentDDLs(generatorMode, sqlParser, options.DesiredDDLs, currentDDLs, options.Config, defaultSchema)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+	if options.Check {
+		result, err := Plan(ctx, generatorMode, db, sqlParser, options)
+		if err != nil {
+			exitWithError(err)
+		}
+		drift := result.drift()
+		if options.Output == OutputJSON {
+			showResultJSON(result, "", true, nil)
+		} else if len(drift) == 0 {
+			fmt.Println("-- Nothing is modified --")
+		} else {
+			fmt.Println("-- Drift detected --")
+			showDriftSummary(drift)
+		}
+		if len(drift) > 0 {
+			os.Exit(ExitCodeDrift)
+		}
+		return
 	}
-	if len(ddls) == 0 {
-		fmt.Println("-- Nothing is modified --")
+
+	if options.WriteMigration != "" {
+		result, err := Plan(ctx, generatorMode, db, sqlParser, options)
+		if err != nil {
+			exitWithError(err)
+		}
+		if options.RollbackOutput != "" {
+			writeRollback(result, options.RollbackOutput, ddlSuffix)
+		}
+		format := options.MigrationFormat
+		if format == "" {
+			format = MigrationFormatGolangMigrate
+		}
+		paths, err := WriteMigration(result, options.WriteMigration, format, time.Now().UTC())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(paths) == 0 { // every change is skipped, if any
+			fmt.Println("-- Nothing is modified --")
+		} else {
+			fmt.Println("-- Write migration --")
+		}
+		for _, path := range paths {
+			fmt.Println(path)
+		}
+		for _, skipped := range result.Skipped {
+			fmt.Printf("-- Skipped: %s;\n", skipped.DDL)
+		}
 		return
 	}
 
 	if options.DryRun || len(options.CurrentFile) > 0 {
-		showDDLs(ddls, options.EnableDrop, options.BeforeApply, ddlSuffix)
+		result, err := Plan(ctx, generatorMode, db, sqlParser, options)
+		if err != nil {
+			exitWithError(err)
+		}
+		if options.RollbackOutput != "" {
+			writeRollback(result, options.RollbackOutput, ddlSuffix)
+		}
+		if options.Output == OutputJSON {
+			showResultJSON(result, options.BeforeApply, true, nil)
+			return
+		}
+		if len(result.Changes) == 0 {
+			fmt.Println("-- Nothing is modified --")
+			return
+		}
+		fmt.Println("-- dry run --")
+		showDDLs(result, options.BeforeApply, ddlSuffix)
+		return
+	}
+
+	applying := false
+	startApply := func() {
+		if !applying {
+			applying = true
+			fmt.Println("-- Apply --")
+			if len(options.BeforeApply) > 0 {
+				fmt.Println(options.BeforeApply)
+			}
+		}
+	}
+	if options.Output != OutputJSON {
+		options.BeforeExecute = func(change schema.Change, skipped bool) {
+			startApply()
+			showStatement(change, skipped, ddlSuffix)
+		}
+	}
+	result, err := Apply(ctx, generatorMode, db, sqlParser, options)
+	if options.RollbackOutput != "" && result != nil {
+		writeRollback(result, options.RollbackOutput, ddlSuffix)
+	}
+	if options.Output == OutputJSON && result != nil {
+		showResultJSON(result, options.BeforeApply, false, err)
+		if err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+	if result != nil && len(result.Changes) == 0 && err == nil {
+		fmt.Println("-- Nothing is modified --")
 		return
 	}
+	if result != nil && len(result.Changes) > 0 {
+		startApply() // when BeforeApply failed
+	}
+	if err != nil {
+		exitWithError(err)
+	}
+}
+
+// Dump the current schema and parse it into DDLs filtered by options.Config.
+func Export(ctx context.Context, generatorMode schema.GeneratorMode, db database.Database, sqlParser database.Parser, options *Options) ([]schema.DDL, error) {
+	currentDDLs, err := dumpDDLs(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	if currentDDLs == "" {
+		return nil, nil
+	}
+
+	ddls, err := schema.ParseDDLs(generatorMode, sqlParser, currentDDLs, db.GetDefaultSchema())
+	if err != nil {
+		return nil, &GenerateError{Err: err}
+	}
+	ddls = schema.FilterTables(ddls, options.Config)
+	ddls = schema.FilterViews(ddls, options.Config)
+	return ddls, nil
+}
+
+// Generate DDLs to migrate the current schema of db to options.DesiredDDLs (or the schema of
+// options.DesiredDatabase) without executing them.
+func Plan(ctx context.Context, generatorMode schema.GeneratorMode, db database.Database, sqlParser database.Parser, options *Options) (*Result, error) {
+	currentDDLs, desiredDDLs, err := dumpSchemas(ctx, db, options)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := schema.GenerateIdempotentDDLs(generatorMode, sqlParser, desiredDDLs, currentDDLs, options.Config, db.GetDefaultSchema())
+	if err != nil {
+		return nil, &GenerateError{Err: err}
+	}
+
+	result := &Result{
+		Changes:         changes,
+		Skipped:         []SkippedChange{},
+		mergeAlterTable: generatorMode == schema.GeneratorModeMysql && !options.Config.DisableMergeAlterTable,
+	}
+	for _, change := range changes {
+		if change.Suggested {
+			reason := "suggested by detect_renames; annotate the column with `@renamed from` to apply it"
+			result.Skipped = append(result.Skipped, SkippedChange{Change: change, Reason: reason})
+		} else if change.Unmanaged {
+			reason := fmt.Sprintf("role %s is not in managed_roles", change.Name)
+			result.Skipped = append(result.Skipped, SkippedChange{Change: change, Reason: reason})
+		} else if change.Destructive && !options.EnableDrop && !slices.Contains(options.Config.EnableDrop, change.Kind.DroppedObject()) {
+			reason := fmt.Sprintf("dropping %s requires --enable-drop or enable_drop: %s", change.Kind.DroppedObject(), change.Kind.DroppedObject())
+			result.Skipped = append(result.Skipped, SkippedChange{Change: change, Reason: reason})
+		}
+	}
+
+	if options.RollbackOutput != "" || options.WriteMigration != "" {
+		// Swap the current schema and the desired one
+		rollback, err := schema.GenerateIdempotentDDLs(generatorMode, sqlParser, currentDDLs, desiredDDLs, options.Config, db.GetDefaultSchema())
+		if err != nil {
+			return nil, &GenerateError{Err: err}
+		}
+		result.Rollback = []schema.Change{}
+		for _, change := range rollback {
+			if !change.Suggested && !change.Unmanaged && !result.isSkippedDrop(change) {
+				result.Rollback = append(result.Rollback, change)
+			}
+		}
+	}
+	return result, nil
+}
+
+// Generate DDLs like Plan, and execute the ones that are not skipped.
+// On *ApplyError, the returned Result is still the whole plan that was attempted.
+func Apply(ctx context.Context, generatorMode schema.GeneratorMode, db database.Database, sqlParser database.Parser, options *Options) (*Result, error) {
+	result, err := Plan(ctx, generatorMode, db, sqlParser, options)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Changes) == 0 {
+		return result, nil
+	}
+	if db.DB() == nil { // e.g. file.FileDatabase
+		return result, &ApplyError{Err: fmt.Errorf("DDLs cannot be applied to a database without a connection")}
+	}
+
+	reporter := newChangeReporter(result, options.BeforeExecute)
+	if options.Config.OnlineSchemaChange != "" {
+		err = runOnlineSchemaChanges(ctx, generatorMode, db, result, reporter, options)
+	} else {
+		changes := result.executedStatements()
+		err = database.RunDDLs(ctx, db, statementsOf(changes), options.BeforeApply, func(i int) {
+			reporter.execute(changes[i])
+		})
+	}
+	if err == nil {
+		reporter.finish()
+	}
+	if err != nil {
+		applyErr := &ApplyError{Err: err}
+		var execErr *database.ExecError
+		if errors.As(err, &execErr) {
+			applyErr.DDL = execErr.DDL
+		}
+		return result, applyErr
+	}
+	return result, nil
+}
+
+// Statements executed together by database.RunDDLs, or `ALTER TABLE` of a table run by the online schema change
+// command when table is not empty
+type applyStep struct {
+	changes []schema.Change
+	table   string
+	clauses []string // clauses following `ALTER TABLE <table>` of changes
+}
+
+// Execute the statements of result like database.RunDDLs, except that `ALTER TABLE` of each table qualified by
+// schema.OnlineSchemaChangeClause is run by a single invocation of options.Config.OnlineSchemaChange.
+// Its output is written to stderr.
+func runOnlineSchemaChanges(ctx context.Context, generatorMode schema.GeneratorMode, db database.Database, result *Result, reporter *changeReporter, options *Options) error {
+	var dbName string
+	if err := db.DB().QueryRowContext(ctx, "SELECT DATABASE()").Scan(&dbName); err != nil {
+		return err
+	}
+
+	steps := result.applySteps(generatorMode, options.Config)
+	beforeApply := options.BeforeApply
+	if beforeApply != "" && (len(steps) == 0 || steps[0].table != "") {
+		steps = append([]*applyStep{{}}, steps...) // run beforeApply first
+	}
+	for _, step := range steps {
+		if step.table == "" {
+			err := database.RunDDLs(ctx, db, statementsOf(step.changes), beforeApply, func(i int) {
+				reporter.execute(step.changes[i])
+			})
+			if err != nil {
+				return err
+			}
+			beforeApply = ""
+			continue
+		}
+
+		for _, change := range step.changes {
+			reporter.execute(change)
+		}
+		cmd := exec.CommandContext(ctx, "sh", "-c", options.Config.OnlineSchemaChange)
+		cmd.Env = append(os.Environ(),
+			"SQLDEF_DATABASE="+dbName,
+			"SQLDEF_TABLE="+step.table,
+			"SQLDEF_ALTER="+strings.Join(step.clauses, ", "),
+		)
+		cmd.Stdout = os.Stderr
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			err = fmt.Errorf("online schema change of table %s failed: %w", step.table, err)
+			return &database.ExecError{DDL: step.changes[0].DDL, Err: err}
+		}
+	}
+	return nil
+}
+
+// Split the executed statements into steps. `ALTER TABLE` of a table run by the online schema change command are
+// grouped into a step at the first of them, and the other statements between such steps are grouped in between.
+func (r *Result) applySteps(generatorMode schema.GeneratorMode, config database.GeneratorConfig) []*applyStep {
+	var steps []*applyStep
+	tableSteps := map[string]*applyStep{}
+	r.eachStatement(func(change schema.Change, skipped bool) bool {
+		if skipped {
+			return true
+		}
+		if clause, ok := schema.OnlineSchemaChangeClause(generatorMode, change, config); ok {
+			step, ok := tableSteps[change.Table]
+			if !ok {
+				step = &applyStep{table: change.Table}
+				tableSteps[change.Table] = step
+				steps = append(steps, step)
+			}
+			step.changes = append(step.changes, change)
+			step.clauses = append(step.clauses, clause)
+			return true
+		}
+		if len(steps) == 0 || steps[len(steps)-1].table != "" {
+			steps = append(steps, &applyStep{})
+		}
+		steps[len(steps)-1].changes = append(steps[len(steps)-1].changes, change)
+		return true
+	})
+	return steps
+}
+
+// Dump the current schema of db, and the desired one of options.DesiredDatabase concurrently if it's given.
+// Otherwise, the desired schema is options.DesiredDDLs.
+func dumpSchemas(ctx context.Context, db database.Database, options *Options) (string, string, error) {
+	if options.DesiredDatabase == nil {
+		currentDDLs, err := dumpDDLs(ctx, db)
+		return currentDDLs, options.DesiredDDLs, err
+	}
 
-	err = database.RunDDLs(db, ddls, options.EnableDrop, options.BeforeApply, ddlSuffix)
+	ddls, err := database.ConcurrentMapFuncWithError([]database.Database{db, options.DesiredDatabase}, -1, func(d database.Database) (string, error) {
+		return dumpDDLs(ctx, d)
+	})
 	if err != nil {
+		return "", "", err
+	}
+	return ddls[0], ddls[1], nil
+}
+
+func dumpDDLs(ctx context.Context, db database.Database) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	currentDDLs, err := db.DumpDDLs()
+	if err != nil {
+		return "", &DumpError{Err: err}
+	}
+	return currentDDLs, nil
+}
+
+// DDLs of Changes
+func (r *Result) DDLs() []string {
+	return schema.ChangeDDLs(r.Changes)
+}
+
+// Changes differing from the current schema, i.e. Changes except Unmanaged ones which are not compared with it
+func (r *Result) drift() []schema.Change {
+	var drift []schema.Change
+	for _, change := range r.Changes {
+		if !change.Unmanaged {
+			drift = append(drift, change)
+		}
+	}
+	return drift
+}
+
+// Statements to be executed, i.e. Changes except Skipped
+func (r *Result) executedStatements() []schema.Change {
+	var statements []schema.Change
+	r.eachStatement(func(change schema.Change, skipped bool) bool {
+		if !skipped {
+			statements = append(statements, change)
+		}
+		return true
+	})
+	return statements
+}
+
+func statementsOf(changes []schema.Change) []database.Statement {
+	var statements []database.Statement
+	for _, change := range changes {
+		statements = append(statements, database.Statement{DDL: change.DDL, Transactional: change.Transactional})
+	}
+	return statements
+}
+
+// Report the statements of a Result to Options.BeforeExecute in the order they're executed. Skipped changes are
+// reported just before the first executed change following them in the plan.
+type changeReporter struct {
+	statements []schema.Change
+	skipped    []bool
+	reported   []bool
+	f          func(change schema.Change, skipped bool)
+}
+
+func newChangeReporter(r *Result, f func(change schema.Change, skipped bool)) *changeReporter {
+	reporter := &changeReporter{f: f}
+	r.eachStatement(func(change schema.Change, skipped bool) bool {
+		reporter.statements = append(reporter.statements, change)
+		reporter.skipped = append(reporter.skipped, skipped)
+		reporter.reported = append(reporter.reported, false)
+		return true
+	})
+	return reporter
+}
+
+// Report change, which is about to be executed, after the Skipped changes preceding it
+func (c *changeReporter) execute(change schema.Change) {
+	for i, statement := range c.statements {
+		if c.reported[i] {
+			continue
+		}
+		if c.skipped[i] {
+			c.report(i)
+		} else if statement == change {
+			c.report(i)
+			return
+		}
+	}
+}
+
+// Report the remaining Skipped changes after all the others are executed
+func (c *changeReporter) finish() {
+	for i := range c.statements {
+		if !c.reported[i] {
+			c.report(i)
+		}
+	}
+}
+
+func (c *changeReporter) report(i int) {
+	c.reported[i] = true
+	if c.f != nil {
+		c.f(c.statements[i], c.skipped[i])
+	}
+}
+
+// Kinds of changes that restore objects dropped by destructive changes
+var restoringChangeKinds = map[schema.ChangeKind]schema.ChangeKind{
+	schema.ChangeDropTable:     schema.ChangeCreateTable,
+	schema.ChangeDropColumn:    schema.ChangeAddColumn,
+	schema.ChangeDropAttribute: schema.ChangeAddAttribute,
+	schema.ChangeDropIndex:     schema.ChangeAddIndex,
+	schema.ChangeDropView:      schema.ChangeCreateView,
+	schema.ChangeDropTrigger:   schema.ChangeCreateTrigger,
+	schema.ChangeDropFunction:  schema.ChangeCreateFunction,
+	schema.ChangeDropSequence:  schema.ChangeCreateSequence,
+	schema.ChangeDropType:      schema.ChangeCreateType,
+	schema.ChangeDropRole:      schema.ChangeCreateRole,
+}
+
+// Whether a rollback change restores an object whose drop is skipped
+func (r *Result) isSkippedDrop(rollback schema.Change) bool {
+	for _, skipped := range r.Skipped {
+		if restoringChangeKinds[skipped.Kind] == rollback.Kind && skipped.Table == rollback.Table && skipped.Name == rollback.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether a change drops data, unlike dropping an index, a view or a trigger which can be recreated
+func dropsData(change schema.Change) bool {
+	return change.Kind == schema.ChangeDropTable || change.Kind == schema.ChangeDropColumn || change.Kind == schema.ChangeDropAttribute
+}
+
+// Warnings about data that cannot be restored by Rollback: data dropped by executed Changes,
+// and data written after the migration that is dropped by Rollback.
+func (r *Result) RollbackWarnings() []string {
+	var executed []schema.Change
+	r.eachChange(func(change schema.Change, skipped bool) bool {
+		if !skipped {
+			executed = append(executed, change)
+		}
+		return true
+	})
+	return rollbackWarnings(executed, r.Rollback)
+}
+
+// Warnings of RollbackWarnings about executed changes and their rollback
+func rollbackWarnings(executed []schema.Change, rollback []schema.Change) []string {
+	var warnings []string
+	for _, change := range executed {
+		if dropsData(change) {
+			warnings = append(warnings, fmt.Sprintf("the rollback cannot restore data dropped by: %s", change.DDL))
+		}
+	}
+	for _, change := range rollback {
+		if dropsData(change) {
+			warnings = append(warnings, fmt.Sprintf("the rollback drops data written after the migration: %s", change.DDL))
+		}
+	}
+	return warnings
+}
+
+// Iterate statements to be executed for Changes like eachChange. When mergeAlterTable is set, changes merged by
+// schema.MergeAlterTables are given instead of the original ones.
+func (r *Result) eachStatement(f func(change schema.Change, skipped bool) bool) {
+	if !r.mergeAlterTable {
+		r.eachChange(f)
+		return
+	}
+	skippedChanges := map[schema.Change]bool{}
+	for _, skipped := range r.Skipped {
+		skippedChanges[skipped.Change] = true
+	}
+	for _, change := range schema.MergeAlterTables(r.Changes, func(change schema.Change) bool { return skippedChanges[change] }) {
+		if !f(change, skippedChanges[change]) {
+			return
+		}
+	}
+}
+
+// Rollback to be executed, which is merged by schema.MergeAlterTables when mergeAlterTable is set
+func (r *Result) rollbackStatements() []schema.Change {
+	if !r.mergeAlterTable {
+		return r.Rollback
+	}
+	return schema.MergeAlterTables(r.Rollback, nil)
+}
+
+// Iterate Changes in order with whether each of them is skipped, until f returns false.
+func (r *Result) eachChange(f func(change schema.Change, skipped bool) bool) {
+	i := 0 // index of the next Skipped change
+	for _, change := range r.Changes {
+		skipped := i < len(r.Skipped) && r.Skipped[i].Change == change
+		if skipped {
+			i++
+		}
+		if !f(change, skipped) {
+			return
+		}
+	}
+}
+
+func exitWithError(err error) {
+	var dumpErr *DumpError
+	var generateErr *GenerateError
+	switch {
+	case errors.As(err, &dumpErr):
+		log.Fatal(dumpErr)
+	case errors.As(err, &generateErr):
+		fmt.Fprintln(os.Stderr, generateErr)
+		os.Exit(1)
+	default:
 		log.Fatal(err)
 	}
 }
@@ -130,18 +712,117 @@ func ReadFile(filepath string) (string, error) {
 	return string(buf), nil
 }
 
-func showDDLs(ddls []string, enableDropTable bool, beforeApply string, ddlSuffix string) {
-	fmt.Println("-- dry run --")
+func showDDLs(result *Result, beforeApply string, ddlSuffix string) {
 	if len(beforeApply) > 0 {
 		fmt.Println(beforeApply)
 	}
-	for _, ddl := range ddls {
-		if !enableDropTable && strings.Contains(ddl, "DROP TABLE") {
-			fmt.Printf("-- Skipped: %s;\n", ddl)
-			continue
+	result.eachStatement(func(change schema.Change, skipped bool) bool {
+		showStatement(change, skipped, ddlSuffix)
+		return true
+	})
+}
+
+func showStatement(change schema.Change, skipped bool, ddlSuffix string) {
+	if skipped {
+		fmt.Printf("-- Skipped: %s;\n", change.DDL)
+		return
+	}
+	fmt.Printf("%s;\n", change.DDL)
+	fmt.Print(ddlSuffix)
+}
+
+// Write Rollback DDLs to filepath with comments of RollbackWarnings, which are also printed to stderr
+func writeRollback(result *Result, filepath string, ddlSuffix string) {
+	var out strings.Builder
+	for _, warning := range result.RollbackWarnings() {
+		fmt.Fprintf(os.Stderr, "-- WARNING: %s\n", warning)
+		fmt.Fprintf(&out, "-- WARNING: %s\n", warning)
+	}
+	for _, change := range result.rollbackStatements() {
+		fmt.Fprintf(&out, "%s;\n", change.DDL)
+		out.WriteString(ddlSuffix)
+	}
+	if err := os.WriteFile(filepath, []byte(out.String()), 0644); err != nil {
+		log.Fatalf("Failed to write '%s': %s", filepath, err)
+	}
+}
+
+// Print changes grouped by the table, or the object itself if it doesn't belong to a table
+func showDriftSummary(drift []schema.Change) {
+	var objects []string
+	changes := map[string][]schema.Change{}
+	for _, change := range drift {
+		object := change.Table
+		if object == "" {
+			object = change.Name
 		}
-		fmt.Printf("%s;\n", ddl)
-		fmt.Print(ddlSuffix)
+		if _, ok := changes[object]; !ok {
+			objects = append(objects, object)
+		}
+		changes[object] = append(changes[object], change)
+	}
+
+	for _, object := range objects {
+		fmt.Printf("%s:\n", object)
+		for _, change := range changes[object] {
+			fmt.Printf("  %s: %s;\n", change.Kind, change.DDL)
+		}
+	}
+}
+
+type jsonResult struct {
+	DryRun      bool            `json:"dry_run"`
+	BeforeApply string          `json:"before_apply,omitempty"`
+	Statements  []schema.Change `json:"statements"`
+	Skipped     []SkippedChange `json:"skipped"`
+	Error       *jsonError      `json:"error,omitempty"`
+}
+
+type jsonError struct {
+	DDL     string `json:"ddl,omitempty"`
+	Message string `json:"message"`
+}
+
+// Print the result of Plan or Apply as JSON. Statements are the changes that are (or would be) executed.
+func showResultJSON(result *Result, beforeApply string, dryRun bool, err error) {
+	output := jsonResult{
+		DryRun:      dryRun,
+		BeforeApply: beforeApply,
+		Statements:  []schema.Change{},
+		Skipped:     result.Skipped,
+	}
+	result.eachStatement(func(change schema.Change, skipped bool) bool {
+		if !skipped {
+			output.Statements = append(output.Statements, change)
+		}
+		return true
+	})
+	if err != nil {
+		output.Error = &jsonError{Message: err.Error()}
+		var applyErr *ApplyError
+		if errors.As(err, &applyErr) {
+			output.Error.DDL = applyErr.DDL
+		}
+	}
+	printJSON(output)
+}
+
+// Print exported DDLs as JSON, one object for each DDL
+func showExportJSON(ddls []schema.DDL) {
+	output := struct {
+		Statements []schema.Change `json:"statements"`
+	}{Statements: []schema.Change{}}
+	for _, ddl := range ddls {
+		output.Statements = append(output.Statements, schema.ChangeForDDL(ddl))
+	}
+	printJSON(output)
+}
+
+func printJSON(v any) {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		log.Fatal(err)
 	}
 }
 
@@ -0,0 +1,199 @@
+package sqldef_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sqldef/sqldef/v2"
+	"github.com/sqldef/sqldef/v2/database"
+	"github.com/sqldef/sqldef/v2/database/file"
+	"github.com/sqldef/sqldef/v2/database/mysql"
+	"github.com/sqldef/sqldef/v2/database/postgres"
+	"github.com/sqldef/sqldef/v2/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanMergesAlterTableByDefault(t *testing.T) {
+	db := fileDatabase(t, "CREATE TABLE users (id bigint NOT NULL);\n")
+	options := &sqldef.Options{
+		DesiredDDLs: "CREATE TABLE users (id bigint NOT NULL, name varchar(40), age int);\n",
+	}
+	ddls := []string{
+		"ALTER TABLE `users` ADD COLUMN `name` varchar(40) AFTER `id`",
+		"ALTER TABLE `users` ADD COLUMN `age` int AFTER `name`",
+	}
+
+	result, err := sqldef.Plan(context.Background(), schema.GeneratorModeMysql, db, mysql.NewParser(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, ddls, result.DDLs())
+	assert.Equal(t, []string{
+		"ALTER TABLE `users` ADD COLUMN `name` varchar(40) AFTER `id`, ADD COLUMN `age` int AFTER `name`",
+	}, schema.ChangeDDLs(result.ExecutedStatements()))
+
+	options.Config.DisableMergeAlterTable = true
+	result, err = sqldef.Plan(context.Background(), schema.GeneratorModeMysql, db, mysql.NewParser(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, ddls, schema.ChangeDDLs(result.ExecutedStatements()))
+}
+
+// Database which dumps the DDLs in a temporary file
+func fileDatabase(t *testing.T, ddls string) file.FileDatabase {
+	path := filepath.Join(t.TempDir(), "current.sql")
+	if err := os.WriteFile(path, []byte(ddls), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file.NewDatabase(path)
+}
+
+func TestPlan(t *testing.T) {
+	db := fileDatabase(t, stripHeredoc(`
+		CREATE TABLE public.users (
+		  id bigint NOT NULL,
+		  name text
+		);
+		CREATE TABLE public.logs (
+		  id bigint NOT NULL
+		);
+	`))
+	options := &sqldef.Options{
+		DesiredDDLs: stripHeredoc(`
+			CREATE TABLE public.users (
+			  id bigint NOT NULL,
+			  name text
+			);
+			CREATE INDEX index_name ON public.users (name);
+		`),
+		RollbackOutput: "-",
+	}
+
+	result, err := sqldef.Plan(context.Background(), schema.GeneratorModePostgres, db, postgres.NewParser(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{
+		"CREATE INDEX index_name ON public.users (name)",
+		`DROP TABLE "public"."logs"`,
+	}, result.DDLs())
+	if assert.Len(t, result.Skipped, 1) {
+		assert.Equal(t, `DROP TABLE "public"."logs"`, result.Skipped[0].Change.DDL)
+		assert.Equal(t, "dropping table requires --enable-drop or enable_drop: table", result.Skipped[0].Reason)
+	}
+	assert.Equal(t, []string{`DROP INDEX "public"."index_name"`}, schema.ChangeDDLs(result.Rollback))
+
+	options.EnableDrop = true
+	result, err = sqldef.Plan(context.Background(), schema.GeneratorModePostgres, db, postgres.NewParser(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, result.Skipped)
+}
+
+func TestApplyWithoutConnection(t *testing.T) {
+	db := fileDatabase(t, "CREATE TABLE users (id bigint NOT NULL);\n")
+	options := &sqldef.Options{DesiredDDLs: "CREATE TABLE users (id bigint NOT NULL);\n"}
+
+	result, err := sqldef.Apply(context.Background(), schema.GeneratorModeMysql, db, mysql.NewParser(), options)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Changes)
+
+	options.DesiredDDLs = "CREATE TABLE users (id bigint NOT NULL, name text);\n"
+	result, err = sqldef.Apply(context.Background(), schema.GeneratorModeMysql, db, mysql.NewParser(), options)
+	var applyErr *sqldef.ApplyError
+	assert.ErrorAs(t, err, &applyErr)
+	assert.Equal(t, []string{"ALTER TABLE `users` ADD COLUMN `name` text AFTER `id`"}, result.DDLs())
+}
+
+func TestExport(t *testing.T) {
+	db := fileDatabase(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL
+		);
+		CREATE TABLE logs (
+		  id bigint NOT NULL
+		);
+		CREATE INDEX index_id ON logs (id);
+	`))
+	options := &sqldef.Options{Config: database.GeneratorConfig{SkipTables: []string{"logs"}}}
+
+	ddls, err := sqldef.Export(context.Background(), schema.GeneratorModeMysql, db, mysql.NewParser(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var statements []string
+	for _, ddl := range ddls {
+		statements = append(statements, ddl.Statement())
+	}
+	assert.Equal(t, []string{"CREATE TABLE users (\n  id bigint NOT NULL\n)"}, statements)
+}
+
+func TestDumpError(t *testing.T) {
+	db := file.NewDatabase(filepath.Join(t.TempDir(), "missing.sql"))
+	var dumpErr *sqldef.DumpError
+
+	_, err := sqldef.Plan(context.Background(), schema.GeneratorModeMysql, db, mysql.NewParser(), &sqldef.Options{})
+	assert.ErrorAs(t, err, &dumpErr)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = sqldef.Export(context.Background(), schema.GeneratorModeMysql, db, mysql.NewParser(), &sqldef.Options{})
+	assert.ErrorAs(t, err, &dumpErr)
+}
+
+func stripHeredoc(heredoc string) string {
+	heredoc = strings.TrimPrefix(heredoc, "\n")
+	re := regexp.MustCompilePOSIX("^\t*")
+	return re.ReplaceAllLiteralString(heredoc, "")
+}
+
+func TestWriteMigrationWithNonTransactionalChange(t *testing.T) {
+	db := fileDatabase(t, "CREATE TABLE public.users (id bigint NOT NULL, name text);\n")
+	options := &sqldef.Options{
+		DesiredDDLs: stripHeredoc(`
+			CREATE TABLE public.users (id bigint NOT NULL, name text);
+			CREATE TABLE public.logs (id bigint NOT NULL, body text);
+			CREATE INDEX CONCURRENTLY index_name ON public.users (name);
+			CREATE INDEX index_id ON public.users (id);
+		`),
+		WriteMigration: "migrations",
+	}
+	result, err := sqldef.Plan(context.Background(), schema.GeneratorModePostgres, db, postgres.NewParser(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	paths, err := sqldef.WriteMigration(result, dir, sqldef.MigrationFormatGolangMigrate, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, path := range paths {
+		files[filepath.Base(path)] = readFile(t, path)
+	}
+	// Each migration is rolled back by its own down migration
+	assert.Equal(t, map[string]string{
+		"20250102030405_sqldef.up.sql": "CREATE TABLE public.logs (id bigint NOT NULL, body text);\n",
+		"20250102030405_sqldef.down.sql": "-- WARNING: the rollback drops data written after the migration: DROP TABLE \"public\".\"logs\"\n" +
+			"DROP TABLE \"public\".\"logs\";\n",
+		"20250102030406_sqldef.up.sql":   "CREATE INDEX CONCURRENTLY index_name ON public.users (name);\n",
+		"20250102030406_sqldef.down.sql": "DROP INDEX \"public\".\"index_name\";\n",
+		"20250102030407_sqldef.up.sql":   "CREATE INDEX index_id ON public.users (id);\n",
+		"20250102030407_sqldef.down.sql": "DROP INDEX \"public\".\"index_id\";\n",
+	}, files)
+}
+
+func readFile(t *testing.T, path string) string {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(buf)
+}